@@ -7,31 +7,60 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 
+	"writers-cli/internal/config"
 	"writers-cli/internal/project"
+	"writers-cli/internal/templates"
 	"writers-cli/internal/themes"
 )
 
-// newCmd creates a new writing file
-var newCmd = &cobra.Command{
-	Use:   "new [name]",
-	Short: "Create a new writing file",
-	Long: `Create a new writing file with optional template.
+// newNewCmd creates the "new" command, which creates a new writing file.
+func newNewCmd(deps *config.Deps) *cobra.Command {
+	var (
+		newTemplate string
+		newOpen     bool
+	)
+
+	var newVars []string
+
+	newCmd := &cobra.Command{
+		Use:   "new [name]",
+		Short: "Create a new writing file",
+		Long: `Create a new writing file with optional template.
 
 Examples:
   writers new story.md              # Create new markdown file
   writers new chapter1 --template novel-chapter
-  writers new flash-fiction --template flash`,
-	Args: cobra.ExactArgs(1),
-	RunE: runNew,
+  writers new flash-fiction --template flash
+  writers new chapter1 --template github.com/user/repo --var title="Chapter One"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runNew(deps, cmd, args, newTemplate, newOpen, newVars)
+		},
+	}
+
+	newCmd.Flags().StringVarP(&newTemplate, "template", "t", "", "template to use")
+	newCmd.Flags().BoolVarP(&newOpen, "open", "o", true, "open file after creation")
+	newCmd.Flags().StringArrayVar(&newVars, "var", nil, "template variable as key=value, repeatable")
+
+	return newCmd
 }
 
-// initCmd initializes a new writing project
-var initCmd = &cobra.Command{
-	Use:   "init [project-name]",
-	Short: "Initialize a new writing project",
-	Long: `Initialize a new writing project with templates and structure.
+// newInitCmd creates the "init" command, which initializes a new project.
+func newInitCmd(deps *config.Deps) *cobra.Command {
+	var (
+		initType        string
+		initTemplate    string
+		initGit         bool
+		initForce       bool
+		initDescription string
+		initVars        []string
+	)
+
+	initCmd := &cobra.Command{
+		Use:   "init [project-name]",
+		Short: "Initialize a new writing project",
+		Long: `Initialize a new writing project with templates and structure.
 
 Project Types:
   novel          - Full novel with chapters and structure
@@ -45,16 +74,31 @@ Project Types:
 Examples:
   writers init my-novel --type novel
   writers init short-collection --type shortstories
-  writers init .  # Initialize in current directory`,
-	Args: cobra.MaximumNArgs(1),
-	RunE: runInit,
+  writers init .  # Initialize in current directory
+  writers init my-novel --template github.com/user/repo --var author="Jane Doe"`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runInit(deps, cmd, args, initType, initTemplate, initGit, initForce, initDescription, initVars)
+		},
+	}
+
+	initCmd.Flags().StringVarP(&initType, "type", "t", "novel", "project type")
+	initCmd.Flags().StringVar(&initTemplate, "template", "", "custom template")
+	initCmd.Flags().BoolVarP(&initGit, "git", "g", true, "initialize git repository")
+	initCmd.Flags().BoolVarP(&initForce, "force", "f", false, "force initialization in non-empty directory")
+	initCmd.Flags().StringVarP(&initDescription, "description", "d", "", "project description")
+	initCmd.Flags().StringArrayVar(&initVars, "var", nil, "template variable as key=value, repeatable")
+
+	return initCmd
 }
 
-// storyCmd manages stories in short story projects
-var storyCmd = &cobra.Command{
-	Use:   "story",
-	Short: "Manage stories in your writing project",
-	Long: `Manage stories, chapters, and other writing pieces in your project.
+// newStoryCmd creates the "story" command and its subcommands, which manage
+// stories in a short-story project.
+func newStoryCmd(deps *config.Deps) *cobra.Command {
+	storyCmd := &cobra.Command{
+		Use:   "story",
+		Short: "Manage stories in your writing project",
+		Long: `Manage stories, chapters, and other writing pieces in your project.
 
 Commands:
   list           - List all stories/chapters
@@ -63,112 +107,81 @@ Commands:
   move           - Move story to different status
   search         - Search through stories
   tag            - Add/remove tags from stories`,
-}
-
-// workflowCmd manages writing workflows
-var workflowCmd = &cobra.Command{
-	Use:   "workflow",
-	Short: "Writing workflow automation",
-	Long: `Automate common writing workflows and tasks.
-
-Workflows:
-  daily          - Daily writing session setup
-  session        - Start a focused writing session
-  submit         - Prepare for submission
-  review         - Review and revision workflow
-  sprint         - Writing sprint timer
-  goal           - Track writing goals`,
-}
-
-// themeCmd manages editor themes
-var themeCmd = &cobra.Command{
-	Use:   "theme",
-	Short: "Manage editor themes",
-	Long: `Manage and customize editor themes.
-
-Commands:
-  list           - List available themes
-  set            - Set default theme
-  create         - Create custom theme
-  export         - Export theme configuration
-  import         - Import theme from file`,
-}
-
-var (
-	// New command flags
-	newTemplate string
-	newOpen     bool
-
-	// Init command flags
-	initType        string
-	initTemplate    string
-	initGit         bool
-	initForce       bool
-	initDescription string
-
-	// Theme command flags
-	themePreview bool
-)
-
-func init() {
-	// New command flags
-	newCmd.Flags().StringVarP(&newTemplate, "template", "t", "", "template to use")
-	newCmd.Flags().BoolVarP(&newOpen, "open", "o", true, "open file after creation")
-
-	// Init command flags
-	initCmd.Flags().StringVarP(&initType, "type", "t", "novel", "project type")
-	initCmd.Flags().StringVar(&initTemplate, "template", "", "custom template")
-	initCmd.Flags().BoolVarP(&initGit, "git", "g", true, "initialize git repository")
-	initCmd.Flags().BoolVarP(&initForce, "force", "f", false, "force initialization in non-empty directory")
-	initCmd.Flags().StringVarP(&initDescription, "description", "d", "", "project description")
-
-	// Theme command flags
-	themeCmd.Flags().BoolVarP(&themePreview, "preview", "p", false, "preview theme")
+	}
 
-	// Add subcommands to story
 	storyCmd.AddCommand(&cobra.Command{
 		Use:   "list",
 		Short: "List all stories",
-		RunE:  runStoryList,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStoryList(deps, cmd, args)
+		},
 	})
 
 	storyCmd.AddCommand(&cobra.Command{
 		Use:   "new [name]",
 		Short: "Create new story",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runStoryNew,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStoryNew(deps, cmd, args)
+		},
 	})
 
-	// Add subcommands to workflow
-	workflowCmd.AddCommand(&cobra.Command{
-		Use:   "daily",
-		Short: "Start daily writing session",
-		RunE:  runWorkflowDaily,
-	})
+	return storyCmd
+}
 
-	workflowCmd.AddCommand(&cobra.Command{
-		Use:   "session [duration]",
-		Short: "Start focused writing session",
-		Args:  cobra.MaximumNArgs(1),
-		RunE:  runWorkflowSession,
-	})
+// newThemeCmd creates the "theme" command and its subcommands, which manage
+// editor themes.
+func newThemeCmd(deps *config.Deps) *cobra.Command {
+	var themePreview bool
+
+	themeCmd := &cobra.Command{
+		Use:   "theme",
+		Short: "Manage editor themes",
+		Long: `Manage and customize editor themes.
+
+Commands:
+  list           - List available themes
+  set            - Set default theme
+  import         - Import a Vim/Neovim or micro colorscheme`,
+	}
+	themeCmd.Flags().BoolVarP(&themePreview, "preview", "p", false, "preview theme")
 
-	// Add subcommands to theme
 	themeCmd.AddCommand(&cobra.Command{
 		Use:   "list",
 		Short: "List available themes",
-		RunE:  runThemeList,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runThemeList(deps, cmd, args)
+		},
 	})
 
 	themeCmd.AddCommand(&cobra.Command{
 		Use:   "set [theme-name]",
 		Short: "Set default theme",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runThemeSet,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runThemeSet(deps, cmd, args)
+		},
+	})
+
+	themeCmd.AddCommand(&cobra.Command{
+		Use:   "import [path]",
+		Short: "Import a Vim/Neovim or micro colorscheme",
+		Long: `Import a Vim/Neovim (.vim) or micro (.micro) colorscheme file so it's
+available alongside the built-in themes.
+
+Examples:
+  writers theme import ~/.vim/colors/gruvbox.vim
+  writers theme import ~/.config/micro/colorschemes/monokai.micro`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runThemeImport(deps, cmd, args)
+		},
 	})
+
+	return themeCmd
 }
 
-func runNew(cmd *cobra.Command, args []string) error {
+func runNew(deps *config.Deps, cmd *cobra.Command, args []string, newTemplate string, newOpen bool, rawVars []string) error {
 	filename := args[0]
 
 	// Ensure .md extension if not provided
@@ -181,24 +194,97 @@ func runNew(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("file %s already exists", filename)
 	}
 
-	// Create file with template content
-	content := getTemplateContent(newTemplate)
+	if templates.IsResolvable(newTemplate) {
+		vars, err := parseVars(rawVars)
+		if err != nil {
+			return err
+		}
+		if err := newFromResolvedTemplate(newTemplate, filename, vars); err != nil {
+			return err
+		}
+	} else {
+		// Create file with template content
+		content := getTemplateContent(newTemplate)
 
-	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
-		return fmt.Errorf("failed to create file: %w", err)
+		if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to create file: %w", err)
+		}
 	}
 
 	fmt.Printf("✅ Created %s\n", filename)
 
 	// Open file if requested
 	if newOpen {
-		return runEdit(cmd, []string{filename})
+		return runEdit(deps, cmd, []string{filename}, defaultEditOptions())
 	}
 
 	return nil
 }
 
-func runInit(cmd *cobra.Command, args []string) error {
+// newFromResolvedTemplate resolves a remote/local --template reference and
+// writes its content to filename. Single-file templates are copied verbatim;
+// directory bundles are materialized into the current directory and filename
+// is left for the manifest (or the bundle's own layout) to provide. overrides
+// fills in the bundle's manifest Variables/Prompts, if it has one.
+func newFromResolvedTemplate(templateArg, filename string, overrides map[string]string) error {
+	src, err := templates.Resolve(templateArg)
+	if err != nil {
+		return fmt.Errorf("failed to resolve template %s: %w", templateArg, err)
+	}
+
+	info, err := os.Stat(src)
+	if err != nil {
+		return fmt.Errorf("failed to stat template %s: %w", src, err)
+	}
+
+	if !info.IsDir() {
+		data, err := os.ReadFile(src)
+		if err != nil {
+			return fmt.Errorf("failed to read template %s: %w", src, err)
+		}
+		return os.WriteFile(filename, data, 0644)
+	}
+
+	vars, err := resolveManifestVariables(src, overrides)
+	if err != nil {
+		return err
+	}
+	return templates.Materialize(src, ".", false, vars)
+}
+
+// resolveManifestVariables loads src's manifest, if it has one, and resolves
+// its Variables/Prompts against overrides. A bundle without a manifest has no
+// variables to substitute.
+func resolveManifestVariables(src string, overrides map[string]string) (map[string]string, error) {
+	manifest, err := templates.LoadManifest(src)
+	if err != nil {
+		return nil, err
+	}
+	if manifest == nil {
+		return nil, nil
+	}
+	return manifest.ResolveVariables(overrides)
+}
+
+// parseVars turns a list of "key=value" --var flags into a map, as consumed
+// by Manifest.ResolveVariables.
+func parseVars(raw []string) (map[string]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	vars := make(map[string]string, len(raw))
+	for _, entry := range raw {
+		key, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --var %q, expected key=value", entry)
+		}
+		vars[key] = value
+	}
+	return vars, nil
+}
+
+func runInit(deps *config.Deps, cmd *cobra.Command, args []string, initType, initTemplate string, initGit, initForce bool, initDescription string, rawVars []string) error {
 	var projectPath string
 
 	if len(args) > 0 {
@@ -234,10 +320,29 @@ func runInit(cmd *cobra.Command, args []string) error {
 		GitInit:     initGit,
 	}
 
-	if err := project.Initialize(projectPath, projectConfig); err != nil {
+	if err := project.Initialize(deps, projectPath, projectConfig, project.DefaultProvider()); err != nil {
 		return fmt.Errorf("failed to initialize project: %w", err)
 	}
 
+	if templates.IsResolvable(initTemplate) {
+		src, err := templates.Resolve(initTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to resolve template %s: %w", initTemplate, err)
+		}
+		overrides, err := parseVars(rawVars)
+		if err != nil {
+			return err
+		}
+		vars, err := resolveManifestVariables(src, overrides)
+		if err != nil {
+			return err
+		}
+		if err := templates.Materialize(src, projectPath, initForce, vars); err != nil {
+			return fmt.Errorf("failed to apply template %s: %w", initTemplate, err)
+		}
+		fmt.Printf("📦 Applied template %s\n", initTemplate)
+	}
+
 	fmt.Printf("🎉 Initialized %s project in %s\n", initType, projectPath)
 
 	if initGit {
@@ -251,7 +356,7 @@ func runInit(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runStoryList(cmd *cobra.Command, args []string) error {
+func runStoryList(deps *config.Deps, cmd *cobra.Command, args []string) error {
 	// TODO: Implement story listing
 	fmt.Println("📖 Stories in current project:")
 	fmt.Println("  - story1.md (draft)")
@@ -260,7 +365,7 @@ func runStoryList(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runStoryNew(cmd *cobra.Command, args []string) error {
+func runStoryNew(deps *config.Deps, cmd *cobra.Command, args []string) error {
 	storyName := args[0]
 
 	// Create story file
@@ -275,28 +380,27 @@ func runStoryNew(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runWorkflowDaily(cmd *cobra.Command, args []string) error {
-	fmt.Println("🌅 Starting daily writing session...")
-	fmt.Println("📊 Yesterday's progress: 750 words")
-	fmt.Printf("🎯 Today's goal: %d words\n", viper.GetInt("writing.daily_goal"))
-	fmt.Println("✍️  Ready to write! Use 'writers edit' to begin.")
-	return nil
-}
+// newConfiguredThemeRegistry builds a ThemeRegistry with the built-in
+// themes plus any user-supplied stylesets and theme files, so "writers
+// theme" sees the same themes F2 cycles through in the editor.
+func newConfiguredThemeRegistry() *themes.ThemeRegistry {
+	registry := themes.NewThemeRegistry()
 
-func runWorkflowSession(cmd *cobra.Command, args []string) error {
-	duration := "25m" // default pomodoro
-	if len(args) > 0 {
-		duration = args[0]
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return registry
 	}
+	configDir := filepath.Join(home, ".config", "writers-cli")
 
-	fmt.Printf("⏰ Starting %s writing session...\n", duration)
-	fmt.Println("🔕 Notifications disabled")
-	fmt.Println("✍️  Focus time! Use 'writers edit' to begin writing.")
-	return nil
+	registry.LoadStylesetsDir(filepath.Join(configDir, "stylesets"))
+	registry.LoadThemesDir(filepath.Join(configDir, "themes"))
+	registry.LoadColorschemesDir(filepath.Join(configDir, "colorschemes"))
+
+	return registry
 }
 
-func runThemeList(cmd *cobra.Command, args []string) error {
-	themeManager := themes.NewManager()
+func runThemeList(deps *config.Deps, cmd *cobra.Command, args []string) error {
+	themeManager := newConfiguredThemeRegistry()
 	availableThemes := themeManager.GetAvailableThemes()
 
 	fmt.Println("🎨 Available themes:")
@@ -307,7 +411,7 @@ func runThemeList(cmd *cobra.Command, args []string) error {
 		}
 
 		current := ""
-		if theme.Name == viper.GetString("editor.theme") {
+		if theme.Name == deps.Viper.GetString("editor.theme") {
 			current = " (current)"
 		}
 
@@ -320,22 +424,22 @@ func runThemeList(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runThemeSet(cmd *cobra.Command, args []string) error {
+func runThemeSet(deps *config.Deps, cmd *cobra.Command, args []string) error {
 	themeName := args[0]
 
 	// Validate theme exists
-	themeManager := themes.NewManager()
+	themeManager := newConfiguredThemeRegistry()
 	if !themeManager.HasTheme(themeName) {
 		return fmt.Errorf("theme '%s' not found. Use 'writers theme list' to see available themes", themeName)
 	}
 
 	// Update config
-	viper.Set("editor.theme", themeName)
+	deps.Viper.Set("editor.theme", themeName)
 
 	// Save config
-	if err := viper.WriteConfig(); err != nil {
+	if err := deps.Viper.WriteConfig(); err != nil {
 		// If config doesn't exist, create it
-		if err := viper.SafeWriteConfig(); err != nil {
+		if err := deps.Viper.SafeWriteConfig(); err != nil {
 			return fmt.Errorf("failed to save theme setting: %w", err)
 		}
 	}
@@ -346,8 +450,61 @@ func runThemeSet(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func getTemplateContent(templateName string) string {
-	templates := map[string]string{
+// runThemeImport validates a Vim/Neovim or micro colorscheme file and copies
+// it into ~/.config/writers-cli/colorschemes, where newConfiguredThemeRegistry
+// and the editor's own theme loading both pick it up on future runs.
+func runThemeImport(deps *config.Deps, cmd *cobra.Command, args []string) error {
+	src := args[0]
+
+	var theme themes.Theme
+	var err error
+	switch strings.ToLower(filepath.Ext(src)) {
+	case ".vim":
+		theme, err = themes.LoadVimColorscheme(src)
+	case ".micro":
+		theme, err = themes.LoadMicroColorscheme(src)
+	default:
+		return fmt.Errorf("unrecognized colorscheme extension for %s (expected .vim or .micro)", src)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse colorscheme %s: %w", src, err)
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return fmt.Errorf("failed to locate home directory: %w", err)
+	}
+	destDir := filepath.Join(home, ".config", "writers-cli", "colorschemes")
+	if err := os.MkdirAll(destDir, 0755); err != nil {
+		return fmt.Errorf("failed to create colorschemes directory: %w", err)
+	}
+
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", src, err)
+	}
+	dest := filepath.Join(destDir, filepath.Base(src))
+	if err := os.WriteFile(dest, data, 0644); err != nil {
+		return fmt.Errorf("failed to import colorscheme: %w", err)
+	}
+
+	fmt.Printf("🎨 Imported colorscheme '%s' from %s\n", theme.GetName(), src)
+	fmt.Printf("💡 Use 'writers theme set %s' to make it your default\n", theme.GetName())
+
+	return nil
+}
+
+// hasBuiltinTemplate reports whether templateName is a recognized key in
+// builtinTemplates, as opposed to falling back to the default template.
+func hasBuiltinTemplate(templateName string) bool {
+	_, exists := builtinTemplates()[templateName]
+	return exists
+}
+
+// builtinTemplates returns the in-tree template keys available to `writers
+// new --template` and `writers copy`, keyed by name with "" as the default.
+func builtinTemplates() map[string]string {
+	return map[string]string{
 		"": `# Untitled
 
 Start writing your story here...
@@ -414,6 +571,10 @@ Wrap up your thoughts...
 
 `,
 	}
+}
+
+func getTemplateContent(templateName string) string {
+	templates := builtinTemplates()
 
 	if content, exists := templates[templateName]; exists {
 		return content
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/spf13/cobra"
+
+	"writers-cli/internal/config"
+)
+
+// newCopyCmd creates the "copy" command, which pushes a snippet onto the OS
+// clipboard.
+func newCopyCmd(deps *config.Deps) *cobra.Command {
+	return &cobra.Command{
+		Use:   "copy <snippet>",
+		Short: "Copy a template, file, or stdin to the clipboard",
+		Long: `Copy a snippet onto the OS clipboard for pasting elsewhere.
+
+<snippet> is resolved, in order, as:
+  -              read from stdin
+  a file path    any file in the current project
+  a template key a key from 'writers new --template' (e.g. novel-chapter)
+
+The result is run through mustache-style variable substitution before it's
+copied: {{author}}, {{date}}, and {{project.name}} are replaced with values
+from the project config (viper) and today's date.
+
+Examples:
+  writers copy novel-chapter          # seed a new scene from a template
+  writers copy chapters/chapter-01.md # move a scene between projects
+  cat notes.md | writers copy -       # copy from stdin`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCopy(deps, cmd, args)
+		},
+	}
+}
+
+func runCopy(deps *config.Deps, cmd *cobra.Command, args []string) error {
+	snippet := args[0]
+
+	content, err := resolveSnippetSource(snippet)
+	if err != nil {
+		return err
+	}
+
+	content = substituteTemplateVars(deps, content)
+
+	if err := clipboard.WriteAll(content); err != nil {
+		return fmt.Errorf("failed to write to clipboard: %w", err)
+	}
+
+	fmt.Printf("📋 Copied %s to clipboard\n", snippet)
+	return nil
+}
+
+// resolveSnippetSource reads "-" from stdin, a path from disk, or falls back
+// to a builtin template key.
+func resolveSnippetSource(snippet string) (string, error) {
+	if snippet == "-" {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read stdin: %w", err)
+		}
+		return string(data), nil
+	}
+
+	if info, err := os.Stat(snippet); err == nil && !info.IsDir() {
+		data, err := os.ReadFile(snippet)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", snippet, err)
+		}
+		return string(data), nil
+	}
+
+	if hasBuiltinTemplate(snippet) {
+		return getTemplateContent(snippet), nil
+	}
+
+	return "", fmt.Errorf("%q is not a file in the current project or a known template key", snippet)
+}
+
+// substituteTemplateVars replaces the small set of mustache-style variables
+// this CLI supports with values from deps.Viper and deps.Clock.
+func substituteTemplateVars(deps *config.Deps, content string) string {
+	vars := map[string]string{
+		"author":       deps.Viper.GetString("author"),
+		"date":         deps.Clock.Now().Format("2006-01-02"),
+		"project.name": deps.Viper.GetString("project.name"),
+	}
+
+	for key, value := range vars {
+		content = strings.ReplaceAll(content, "{{"+key+"}}", value)
+	}
+
+	return content
+}
@@ -5,16 +5,48 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 
+	"writers-cli/internal/browser"
+	"writers-cli/internal/config"
 	"writers-cli/internal/editor"
+	"writers-cli/internal/project"
+	"writers-cli/internal/themes"
 )
 
-var editCmd = &cobra.Command{
-	Use:   "edit [file]",
-	Short: "Launch the beautiful themed editor",
-	Long: `Launch the Writers CLI Editor with beautiful themes and modal editing.
+// editOptions holds the edit command's flag values, independent of any
+// package-level state so runEdit can be driven directly (e.g. by `writers
+// new --open`) without going through cobra flag parsing.
+type editOptions struct {
+	Theme           string
+	LineNumbers     bool
+	Typewriter      bool
+	DistractionFree bool
+	AutoSave        bool
+	ReadOnly        bool
+	Syntax          string
+	AllFiles        bool
+}
+
+// defaultEditOptions mirrors the edit command's flag defaults, for callers
+// that invoke runEdit without going through the "edit" subcommand itself.
+func defaultEditOptions() editOptions {
+	return editOptions{
+		LineNumbers: true,
+		AutoSave:    true,
+		Syntax:      "markdown",
+	}
+}
+
+// newEditCmd creates the "edit" command, which launches the TUI editor.
+func newEditCmd(deps *config.Deps) *cobra.Command {
+	opts := defaultEditOptions()
+
+	editCmd := &cobra.Command{
+		Use:   "edit [file]",
+		Short: "Launch the beautiful themed editor",
+		Long: `Launch the Writers CLI Editor with beautiful themes and modal editing.
 
 🎨 Features:
   • Beautiful dark and light themes with syntax highlighting
@@ -43,38 +75,32 @@ var editCmd = &cobra.Command{
 
 The editor will automatically detect if you're working within a writing project
 and provide context-aware features and templates.`,
-	Args: cobra.MaximumNArgs(1),
-	RunE: runEdit,
-}
-
-var (
-	editTheme       string
-	editLineNumbers bool
-	editTypewriter  bool
-	editDistraction bool
-	editAutoSave    bool
-	editReadOnly    bool
-	editSyntax      string
-)
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEdit(deps, cmd, args, opts)
+		},
+	}
 
-func init() {
 	// Editor behavior flags
-	editCmd.Flags().StringVarP(&editTheme, "theme", "t", "", "editor theme (dark, light, base)")
-	editCmd.Flags().BoolVarP(&editLineNumbers, "line-numbers", "n", true, "show line numbers")
-	editCmd.Flags().BoolVarP(&editTypewriter, "typewriter", "", false, "start in typewriter mode")
-	editCmd.Flags().BoolVarP(&editDistraction, "distraction-free", "d", false, "start in distraction-free mode")
-	editCmd.Flags().BoolVarP(&editAutoSave, "auto-save", "a", true, "enable auto-save")
-	editCmd.Flags().BoolVarP(&editReadOnly, "read-only", "r", false, "open in read-only mode")
-	editCmd.Flags().StringVarP(&editSyntax, "syntax", "s", "markdown", "syntax highlighting mode")
+	editCmd.Flags().StringVarP(&opts.Theme, "theme", "t", "", "editor theme (dark, light, base)")
+	editCmd.Flags().BoolVarP(&opts.LineNumbers, "line-numbers", "n", true, "show line numbers")
+	editCmd.Flags().BoolVarP(&opts.Typewriter, "typewriter", "", false, "start in typewriter mode")
+	editCmd.Flags().BoolVarP(&opts.DistractionFree, "distraction-free", "d", false, "start in distraction-free mode")
+	editCmd.Flags().BoolVarP(&opts.AutoSave, "auto-save", "a", true, "enable auto-save")
+	editCmd.Flags().BoolVarP(&opts.ReadOnly, "read-only", "r", false, "open in read-only mode")
+	editCmd.Flags().StringVarP(&opts.Syntax, "syntax", "s", "markdown", "syntax highlighting mode")
+	editCmd.Flags().BoolVar(&opts.AllFiles, "all-files", false, "show all files, not just markdown, when browsing a directory")
 
 	// Bind flags to viper
-	viper.BindPFlag("editor.theme", editCmd.Flags().Lookup("theme"))
-	viper.BindPFlag("editor.show_line_numbers", editCmd.Flags().Lookup("line-numbers"))
-	viper.BindPFlag("editor.typewriter_mode", editCmd.Flags().Lookup("typewriter"))
-	viper.BindPFlag("editor.auto_save", editCmd.Flags().Lookup("auto-save"))
+	deps.Viper.BindPFlag("editor.theme", editCmd.Flags().Lookup("theme"))
+	deps.Viper.BindPFlag("editor.show_line_numbers", editCmd.Flags().Lookup("line-numbers"))
+	deps.Viper.BindPFlag("editor.typewriter_mode", editCmd.Flags().Lookup("typewriter"))
+	deps.Viper.BindPFlag("editor.auto_save", editCmd.Flags().Lookup("auto-save"))
+
+	return editCmd
 }
 
-func runEdit(cmd *cobra.Command, args []string) error {
+func runEdit(deps *config.Deps, cmd *cobra.Command, args []string, opts editOptions) error {
 	var filePath string
 
 	// Determine file path
@@ -84,7 +110,7 @@ func runEdit(cmd *cobra.Command, args []string) error {
 		// Handle special cases
 		if filePath == "." {
 			// Browse current directory
-			return browseDirectory(".")
+			return browseDirectory(deps, ".", opts)
 		}
 
 		// Resolve relative paths
@@ -97,36 +123,22 @@ func runEdit(cmd *cobra.Command, args []string) error {
 		// Check if file exists and is accessible
 		if info, err := os.Stat(filePath); err == nil {
 			if info.IsDir() {
-				return browseDirectory(filePath)
+				return browseDirectory(deps, filePath, opts)
 			}
 		}
 	}
 
 	// Create editor configuration from flags and config
-	config := &editor.Config{
-		Theme:            getTheme(),
-		ShowLineNumbers:  viper.GetBool("editor.show_line_numbers"),
-		TypewriterMode:   viper.GetBool("editor.typewriter_mode"),
-		DistractionFree:  editDistraction,
-		AutoSave:         viper.GetBool("editor.auto_save"),
-		AutoSaveInterval: viper.GetDuration("editor.auto_save_interval"),
-		ReadOnly:         editReadOnly,
-		SyntaxMode:       editSyntax,
-		TabSize:          viper.GetInt("editor.tab_size"),
-		WrapText:         viper.GetBool("editor.wrap_text"),
-		WordsPerMinute:   viper.GetInt("writing.words_per_minute"),
-		ShowWordCount:    viper.GetBool("writing.show_word_count"),
-		ShowReadingTime:  viper.GetBool("writing.show_reading_time"),
-		Debug:            viper.GetBool("debug"),
-	}
+	econfig := buildEditorConfig(deps, opts)
+	econfig.AutoCommit = detectAutoCommit(deps, filePath)
 
 	// Create and launch editor
-	ed := editor.New(config)
+	ed := editor.New(econfig)
 
-	if viper.GetBool("debug") {
+	if deps.Viper.GetBool("debug") {
 		fmt.Printf("Launching editor with file: %s\n", filePath)
-		fmt.Printf("Theme: %s\n", config.Theme)
-		fmt.Printf("Config: %+v\n", config)
+		fmt.Printf("Theme: %s\n", econfig.Theme)
+		fmt.Printf("Config: %+v\n", econfig)
 	}
 
 	// Launch the editor
@@ -137,22 +149,95 @@ func runEdit(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func getTheme() string {
-	// Priority: flag > config > default
-	if editTheme != "" {
-		return editTheme
+// buildEditorConfig assembles an editor.Config from flags and viper, shared
+// by runEdit and browseDirectory.
+func buildEditorConfig(deps *config.Deps, opts editOptions) *editor.Config {
+	v := deps.Viper
+	return &editor.Config{
+		Theme:            getTheme(deps, opts),
+		ShowLineNumbers:  v.GetBool("editor.show_line_numbers"),
+		TypewriterMode:   v.GetBool("editor.typewriter_mode"),
+		DistractionFree:  opts.DistractionFree,
+		AutoSave:         v.GetBool("editor.auto_save"),
+		AutoSaveInterval: v.GetDuration("editor.auto_save_interval"),
+		ReadOnly:         opts.ReadOnly,
+		SyntaxMode:       opts.Syntax,
+		TabSize:          v.GetInt("editor.tab_size"),
+		WrapText:         v.GetBool("editor.wrap_text"),
+		WordsPerMinute:   v.GetInt("writing.words_per_minute"),
+		ShowWordCount:    v.GetBool("writing.show_word_count"),
+		ShowReadingTime:  v.GetBool("writing.show_reading_time"),
+		Debug:            v.GetBool("debug"),
+	}
+}
+
+// detectAutoCommit walks up from filePath looking for a project's
+// .writers-project.yml and reports whether it opts into Settings.AutoCommit.
+// It returns false if filePath isn't inside a writers project. A config
+// that exists but fails validation (project.Load) is reported to the user
+// instead of silently falling through to a parent directory.
+func detectAutoCommit(deps *config.Deps, filePath string) bool {
+	if filePath == "" {
+		return false
+	}
+
+	dir := filepath.Dir(filePath)
+	for {
+		configPath := filepath.Join(dir, project.ConfigFileName)
+		if exists, _ := afero.Exists(deps.Fs, configPath); exists {
+			proj, err := project.Load(deps, dir, themes.NewManager().ListThemeNames())
+			if err != nil {
+				fmt.Fprintf(deps.ErrOut, "warning: %v\n", err)
+				return false
+			}
+			return proj.Settings.AutoCommit
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return false
+		}
+		dir = parent
 	}
+}
 
-	theme := viper.GetString("editor.theme")
-	if theme == "" {
-		theme = "dark" // default
+func getTheme(deps *config.Deps, opts editOptions) string {
+	// Priority: flag > persisted config > "" (let the editor fall back to
+	// its own saved state / project override / OS appearance / built-in
+	// default, instead of forcing "dark" here and pre-empting all of that).
+	if opts.Theme != "" {
+		return opts.Theme
 	}
 
-	return theme
+	// setDefaults gives editor.theme a default, so only a value actually
+	// read from the config file counts as persisted.
+	if deps.Viper.InConfig("editor.theme") {
+		return deps.Viper.GetString("editor.theme")
+	}
+	return ""
 }
 
-func browseDirectory(dirPath string) error {
-	// TODO: Implement directory browser
-	// For now, just show an error
-	return fmt.Errorf("directory browsing not yet implemented. Please specify a file to edit")
+func browseDirectory(deps *config.Deps, dirPath string, opts editOptions) error {
+	result, err := browser.Run(dirPath, browser.Options{
+		AllFiles:  opts.AllFiles,
+		DailyGoal: deps.Viper.GetInt("writing.daily_goal"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to browse directory: %w", err)
+	}
+
+	if result.Selected == "" {
+		// User quit without picking a file.
+		return nil
+	}
+
+	econfig := buildEditorConfig(deps, opts)
+	econfig.AutoCommit = detectAutoCommit(deps, result.Selected)
+
+	ed := editor.New(econfig)
+	if err := ed.Launch(result.Selected); err != nil {
+		return fmt.Errorf("failed to launch editor: %w", err)
+	}
+
+	return nil
 }
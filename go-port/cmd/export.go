@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"writers-cli/internal/config"
+	"writers-cli/internal/project"
+	"writers-cli/internal/references"
+)
+
+// newExportCmd creates the "export" command, which renders the current
+// project to PDF (or, for a screenplay, PDF/FDX).
+func newExportCmd(deps *config.Deps) *cobra.Command {
+	var style string
+	var format string
+
+	exportCmd := &cobra.Command{
+		Use:   "export [output.pdf]",
+		Short: "Export the project to PDF (or a screenplay to PDF/FDX)",
+		Long: `Export the current project.
+
+With the default --format=thesis, renders thesis.md to the given output.pdf,
+resolving "[@key]" citations against the project's references/ directory
+(.bib and CSL-JSON files, see "writers ref") and appending a compiled
+bibliography.
+
+With --format=fountain-pdf or --format=fdx, renders the project's .fountain
+screenplay to an industry-standard screenplay PDF or Final Draft XML file
+instead, written alongside the source; no output path argument is needed.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExport(deps, args, style, format)
+		},
+	}
+	exportCmd.Flags().StringVar(&style, "style", string(references.APA), "citation style: apa, mla, or chicago")
+	exportCmd.Flags().StringVar(&format, "format", "thesis", "export format: thesis, fountain-pdf, or fdx")
+
+	return exportCmd
+}
+
+func runExport(deps *config.Deps, args []string, style, format string) error {
+	switch project.ExportFormat(format) {
+	case project.ExportFountainPDF, project.ExportFDX:
+		outPath, err := project.Export(".", project.ExportFormat(format))
+		if err != nil {
+			return fmt.Errorf("failed to export: %w", err)
+		}
+		fmt.Printf("📄 Exported %s\n", outPath)
+		return nil
+	case "thesis":
+		if len(args) != 1 {
+			return fmt.Errorf("export --format=thesis requires an output path, e.g. \"writers export thesis.pdf\"")
+		}
+		outPath := args[0]
+		if err := project.ExportThesisPDF(".", outPath, references.Style(style)); err != nil {
+			return fmt.Errorf("failed to export: %w", err)
+		}
+		fmt.Printf("📄 Exported %s\n", outPath)
+		return nil
+	default:
+		return fmt.Errorf("unknown export format %q (expected thesis, fountain-pdf, or fdx)", format)
+	}
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/spf13/cobra"
+
+	"writers-cli/internal/config"
+)
+
+// cursorMarker is the bookmark writers drop into a file to mark where
+// `writers paste --at-cursor` should insert clipboard content.
+const cursorMarker = "<<cursor>>"
+
+// pastePlacement picks where paste inserts clipboard content.
+type pastePlacement struct {
+	Append   bool
+	Prepend  bool
+	AtCursor bool
+}
+
+// newPasteCmd creates the "paste" command, which inserts clipboard content
+// into a target file.
+func newPasteCmd(deps *config.Deps) *cobra.Command {
+	var placement pastePlacement
+
+	pasteCmd := &cobra.Command{
+		Use:   "paste <file>",
+		Short: "Insert clipboard content into a file",
+		Long: `Insert the current OS clipboard content into a target file.
+
+Exactly one placement flag chooses where the content goes:
+  --append     (default) add to the end of the file
+  --prepend    add to the start of the file
+  --at-cursor  replace a "` + cursorMarker + `" bookmark marker with the content
+
+Word counts are reprinted after the file is updated.
+
+Examples:
+  writers paste chapters/chapter-01.md              # append
+  writers paste --prepend notes.md
+  writers paste --at-cursor chapters/chapter-01.md  # drop in at ` + cursorMarker + ``,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPaste(cmd, args, placement)
+		},
+	}
+
+	pasteCmd.Flags().BoolVar(&placement.Append, "append", false, "append clipboard content to the end of the file (default)")
+	pasteCmd.Flags().BoolVar(&placement.Prepend, "prepend", false, "prepend clipboard content to the start of the file")
+	pasteCmd.Flags().BoolVar(&placement.AtCursor, "at-cursor", false, "replace the "+cursorMarker+" bookmark marker with the content")
+
+	return pasteCmd
+}
+
+func runPaste(cmd *cobra.Command, args []string, placement pastePlacement) error {
+	filename := args[0]
+
+	if placement.Prepend && placement.AtCursor || placement.Append && placement.AtCursor || placement.Append && placement.Prepend {
+		return fmt.Errorf("--append, --prepend, and --at-cursor are mutually exclusive")
+	}
+
+	clip, err := clipboard.ReadAll()
+	if err != nil {
+		return fmt.Errorf("failed to read clipboard: %w", err)
+	}
+
+	existing, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", filename, err)
+	}
+
+	updated, err := placeClipboardContent(string(existing), clip, placement)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filename, []byte(updated), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", filename, err)
+	}
+
+	fmt.Printf("📋 Pasted into %s\n", filename)
+	fmt.Printf("📊 Word count: %d\n", len(strings.Fields(updated)))
+
+	return nil
+}
+
+// placeClipboardContent combines the existing file content with clip
+// according to the paste placement.
+func placeClipboardContent(existing, clip string, placement pastePlacement) (string, error) {
+	switch {
+	case placement.AtCursor:
+		if !strings.Contains(existing, cursorMarker) {
+			return "", fmt.Errorf("no %s bookmark found in file", cursorMarker)
+		}
+		return strings.Replace(existing, cursorMarker, clip, 1), nil
+
+	case placement.Prepend:
+		return clip + "\n" + existing, nil
+
+	default:
+		return existing + "\n" + clip, nil
+	}
+}
@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"writers-cli/internal/config"
+)
+
+// newPreviewCmd creates the "preview" command, which renders a manuscript
+// through Glamour.
+func newPreviewCmd(deps *config.Deps) *cobra.Command {
+	var (
+		previewStyle string
+		previewWidth int
+		previewWatch bool
+	)
+
+	previewCmd := &cobra.Command{
+		Use:   "preview [file]",
+		Short: "Render a manuscript with Glamour styling",
+		Long: `Render a markdown file, or a whole project's assembled chapters, to the
+terminal using Glamour.
+
+Examples:
+  writers preview chapter1.md          # Render a single file
+  writers preview                      # Render the project's chapters, in order
+  writers preview --style light        # Use a built-in or theme-derived style
+  writers preview -w chapter1.md       # Re-render whenever the file changes
+  cat chapter1.md | writers preview -  # Render from stdin`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPreview(cmd, args, previewStyle, previewWidth, previewWatch)
+		},
+	}
+
+	previewCmd.Flags().StringVar(&previewStyle, "style", "auto", "glamour style (ascii, dark, light, notty, auto) or a registered theme name")
+	previewCmd.Flags().IntVar(&previewWidth, "width", 0, "wrap width (defaults to terminal width)")
+	previewCmd.Flags().BoolVarP(&previewWatch, "watch", "w", false, "re-render when the file changes")
+
+	return previewCmd
+}
+
+func runPreview(cmd *cobra.Command, args []string, style string, width int, watch bool) error {
+	target := ""
+	if len(args) > 0 {
+		target = args[0]
+	}
+
+	if watch {
+		if target == "" || target == "-" {
+			return fmt.Errorf("--watch requires a file argument")
+		}
+		return watchAndRender(target, style, width)
+	}
+
+	content, err := readPreviewSource(target)
+	if err != nil {
+		return err
+	}
+
+	rendered, err := renderMarkdown(content, style, width)
+	if err != nil {
+		return fmt.Errorf("failed to render markdown: %w", err)
+	}
+
+	return writeOutput(rendered)
+}
+
+// readPreviewSource resolves "-" (stdin), a single file, or, when target is
+// empty, the project's assembled chapters.
+func readPreviewSource(target string) (string, error) {
+	switch {
+	case target == "-":
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read stdin: %w", err)
+		}
+		return string(data), nil
+	case target != "":
+		data, err := os.ReadFile(target)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", target, err)
+		}
+		return string(data), nil
+	default:
+		return assembleProjectChapters(".")
+	}
+}
+
+// assembleProjectChapters concatenates every markdown file under
+// projectPath/chapters, in filename order, separated by scene breaks.
+func assembleProjectChapters(projectPath string) (string, error) {
+	chaptersDir := filepath.Join(projectPath, "chapters")
+
+	entries, err := os.ReadDir(chaptersDir)
+	if err != nil {
+		return "", fmt.Errorf("no file given and no chapters/ directory found: %w", err)
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".md") {
+			continue
+		}
+		files = append(files, entry.Name())
+	}
+	sort.Strings(files)
+
+	if len(files) == 0 {
+		return "", fmt.Errorf("no markdown files found in %s", chaptersDir)
+	}
+
+	var b strings.Builder
+	for i, name := range files {
+		if i > 0 {
+			b.WriteString("\n\n---\n\n")
+		}
+		content, err := os.ReadFile(filepath.Join(chaptersDir, name))
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		b.Write(content)
+	}
+
+	return b.String(), nil
+}
+
+// renderMarkdown renders content through Glamour using the given style and
+// wrap width (0 to use the terminal width).
+func renderMarkdown(content, style string, width int) (string, error) {
+	if width <= 0 {
+		width = terminalWidth()
+	}
+
+	opts := []glamour.TermRendererOption{
+		glamour.WithStylePath(resolveGlamourStyle(style)),
+		glamour.WithWordWrap(width),
+	}
+
+	renderer, err := glamour.NewTermRenderer(opts...)
+	if err != nil {
+		return "", err
+	}
+
+	return renderer.Render(content)
+}
+
+// resolveGlamourStyle maps previewStyle to a Glamour built-in style name,
+// falling back to deriving "dark"/"light" from a registered theme (a
+// built-in, a user theme file or styleset, or a colorscheme imported via
+// `writers theme import`).
+func resolveGlamourStyle(style string) string {
+	switch style {
+	case "", "auto", "ascii", "dark", "light", "notty", "pink", "dracula":
+		return style
+	}
+
+	manager := newConfiguredThemeRegistry()
+	if theme, ok := manager.GetTheme(style); ok {
+		if theme.IsDark() {
+			return "dark"
+		}
+		return "light"
+	}
+
+	return "auto"
+}
+
+// writeOutput prints rendered content directly, or through $PAGER when
+// stdout is a terminal and a pager is configured.
+func writeOutput(rendered string) error {
+	pager := os.Getenv("PAGER")
+	if pager == "" || !isTerminal(os.Stdout) {
+		fmt.Print(rendered)
+		return nil
+	}
+
+	pagerCmd := exec.Command("sh", "-c", pager)
+	pagerCmd.Stdout = os.Stdout
+	pagerCmd.Stderr = os.Stderr
+
+	stdin, err := pagerCmd.StdinPipe()
+	if err != nil {
+		fmt.Print(rendered)
+		return nil
+	}
+
+	if err := pagerCmd.Start(); err != nil {
+		fmt.Print(rendered)
+		return nil
+	}
+
+	io.WriteString(stdin, rendered)
+	stdin.Close()
+
+	return pagerCmd.Wait()
+}
+
+// terminalWidth returns stdout's width, falling back to 80 columns when it
+// can't be determined (e.g. output is piped).
+func terminalWidth() int {
+	if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil && width > 0 {
+		return width
+	}
+	return 80
+}
+
+// isTerminal reports whether f looks like an interactive terminal.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return (info.Mode() & os.ModeCharDevice) != 0
+}
+
+// watchAndRender re-renders target every time it changes on disk, clearing
+// the screen between renders for a two-pane edit/preview workflow.
+func watchAndRender(target, style string, width int) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to start file watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(filepath.Dir(target)); err != nil {
+		return fmt.Errorf("failed to watch %s: %w", target, err)
+	}
+
+	renderOnce := func() {
+		content, err := readPreviewSource(target)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		rendered, err := renderMarkdown(content, style, width)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		fmt.Print("\033[H\033[2J") // clear screen
+		fmt.Print(rendered)
+	}
+
+	renderOnce()
+
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if filepath.Clean(event.Name) != filepath.Clean(target) {
+				continue
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create) != 0 {
+				renderOnce()
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			fmt.Fprintln(os.Stderr, "watch error:", err)
+		}
+	}
+}
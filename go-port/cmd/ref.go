@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"writers-cli/internal/config"
+	"writers-cli/internal/references"
+)
+
+// referencesDir is where a project's .bib/CSL-JSON bibliography files live,
+// matching the academic template's seed directory.
+const referencesDir = "references"
+
+// referencesLibraryFile is where "writers ref add" appends CrossRef
+// lookups, as a CSL-JSON array alongside any hand-written .bib files.
+const referencesLibraryFile = "library.json"
+
+// newRefCmd creates the "ref" command and its subcommands, which manage the
+// bibliography a project's "[@key]" citations resolve against.
+func newRefCmd(deps *config.Deps) *cobra.Command {
+	refCmd := &cobra.Command{
+		Use:   "ref",
+		Short: "Manage a project's bibliography",
+		Long: `Manage the references a project's citations ("[@key]" in thesis.md)
+resolve against.
+
+Commands:
+  add   - Fetch a reference's metadata from CrossRef by DOI and save it
+  list  - List the project's saved references`,
+	}
+
+	refCmd.AddCommand(&cobra.Command{
+		Use:   "add <doi>",
+		Short: "Fetch a reference from CrossRef by DOI and add it to the bibliography",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRefAdd(deps, args)
+		},
+	})
+
+	refCmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List the project's saved references",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRefList(deps)
+		},
+	})
+
+	return refCmd
+}
+
+func runRefAdd(deps *config.Deps, args []string) error {
+	doi := args[0]
+
+	ref, err := references.FetchByDOI(doi)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", doi, err)
+	}
+
+	path := filepath.Join(referencesDir, referencesLibraryFile)
+	if err := references.AppendCSLJSON(path, ref); err != nil {
+		return fmt.Errorf("failed to save reference: %w", err)
+	}
+
+	fmt.Printf("📚 Added [@%s]: %s\n", ref.Key, ref.Title)
+	return nil
+}
+
+func runRefList(deps *config.Deps) error {
+	refs, err := references.LoadDir(referencesDir)
+	if err != nil {
+		return fmt.Errorf("failed to load references: %w", err)
+	}
+	if len(refs) == 0 {
+		fmt.Println("No references recorded yet")
+		return nil
+	}
+
+	keys := make([]string, 0, len(refs))
+	for key := range refs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fmt.Printf("[@%s] %s\n", key, references.Cite(refs[key], references.APA))
+	}
+	return nil
+}
@@ -6,15 +6,27 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+
+	"writers-cli/internal/config"
 )
 
-var cfgFile string
+// Execute builds the command tree against a fresh Deps and runs it. Deps is
+// constructed once here, per process, and threaded through every command
+// constructor instead of being read from package-global state.
+func Execute() error {
+	deps := config.New()
+	return newRootCmd(deps).Execute()
+}
+
+// newRootCmd assembles the base "writers" command and all of its
+// subcommands against deps.
+func newRootCmd(deps *config.Deps) *cobra.Command {
+	var cfgFile string
 
-// rootCmd represents the base command when called without any subcommands
-var rootCmd = &cobra.Command{
-	Use:   "writers",
-	Short: "A beautiful CLI tool for writers with dark themes and modal editing",
-	Long: `Writers CLI - A Professional Writing Environment
+	rootCmd := &cobra.Command{
+		Use:   "writers",
+		Short: "A beautiful CLI tool for writers with dark themes and modal editing",
+		Long: `Writers CLI - A Professional Writing Environment
 
 A modern, feature-rich command-line editor designed specifically for writers.
 Features include:
@@ -38,87 +50,95 @@ Features include:
   • Cross-platform clipboard support
 
 Perfect for novels, short stories, articles, and any serious writing work.`,
-	Version: "2.0.0",
-}
-
-// Execute adds all child commands to the root command and sets flags appropriately.
-func Execute() error {
-	return rootCmd.Execute()
-}
-
-func init() {
-	cobra.OnInitialize(initConfig)
+		Version: "2.0.0",
+	}
 
-	// Global flags
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.writers-cli.yaml)")
 	rootCmd.PersistentFlags().Bool("debug", false, "enable debug mode")
-
-	// Bind flags to viper
-	viper.BindPFlag("debug", rootCmd.PersistentFlags().Lookup("debug"))
-
-	// Add subcommands
-	rootCmd.AddCommand(newCmd)
-	rootCmd.AddCommand(editCmd)
-	rootCmd.AddCommand(initCmd)
-	rootCmd.AddCommand(storyCmd)
-	rootCmd.AddCommand(workflowCmd)
-	rootCmd.AddCommand(themeCmd)
+	deps.Viper.BindPFlag("debug", rootCmd.PersistentFlags().Lookup("debug"))
+
+	cobra.OnInitialize(func() {
+		initConfig(deps, cfgFile)
+	})
+
+	rootCmd.AddCommand(newNewCmd(deps))
+	rootCmd.AddCommand(newEditCmd(deps))
+	rootCmd.AddCommand(newInitCmd(deps))
+	rootCmd.AddCommand(newStoryCmd(deps))
+	rootCmd.AddCommand(newSubCmd(deps))
+	rootCmd.AddCommand(newRefCmd(deps))
+	rootCmd.AddCommand(newExportCmd(deps))
+	rootCmd.AddCommand(newWorkflowCmd(deps))
+	rootCmd.AddCommand(newThemeCmd(deps))
+	rootCmd.AddCommand(newTemplateCmd(deps))
+	rootCmd.AddCommand(newPreviewCmd(deps))
+	rootCmd.AddCommand(newCopyCmd(deps))
+	rootCmd.AddCommand(newPasteCmd(deps))
+
+	return rootCmd
 }
 
 // initConfig reads in config file and ENV variables if set.
-func initConfig() {
+func initConfig(deps *config.Deps, cfgFile string) {
+	v := deps.Viper
+
 	if cfgFile != "" {
 		// Use config file from the flag.
-		viper.SetConfigFile(cfgFile)
+		v.SetConfigFile(cfgFile)
 	} else {
 		// Find home directory.
 		home, err := os.UserHomeDir()
 		cobra.CheckErr(err)
 
 		// Search config in home directory with name ".writers-cli" (without extension).
-		viper.AddConfigPath(home)
-		viper.SetConfigType("yaml")
-		viper.SetConfigName(".writers-cli")
+		v.AddConfigPath(home)
+		v.SetConfigType("yaml")
+		v.SetConfigName(".writers-cli")
 	}
 
-	viper.AutomaticEnv() // read in environment variables that match
+	v.AutomaticEnv() // read in environment variables that match
 
 	// If a config file is found, read it in.
-	if err := viper.ReadInConfig(); err == nil {
-		if viper.GetBool("debug") {
-			fmt.Fprintln(os.Stderr, "Using config file:", viper.ConfigFileUsed())
+	if err := v.ReadInConfig(); err == nil {
+		if v.GetBool("debug") {
+			fmt.Fprintln(deps.ErrOut, "Using config file:", v.ConfigFileUsed())
 		}
 	}
 
 	// Set default values
-	setDefaults()
+	setDefaults(v)
 }
 
-func setDefaults() {
+// setDefaults populates v with every setting a fresh project relies on, so
+// commands can always read a value even before a config file exists.
+func setDefaults(v *viper.Viper) {
 	// Editor defaults
-	viper.SetDefault("editor.theme", "dark")
-	viper.SetDefault("editor.show_line_numbers", true)
-	viper.SetDefault("editor.auto_save", true)
-	viper.SetDefault("editor.auto_save_interval", "30s")
-	viper.SetDefault("editor.tab_size", 2)
-	viper.SetDefault("editor.wrap_text", false)
-	viper.SetDefault("editor.typewriter_mode", false)
-	viper.SetDefault("editor.typewriter_position", 0.66)
-	viper.SetDefault("editor.typewriter_focus_lines", 1)
+	v.SetDefault("editor.theme", "dark")
+	v.SetDefault("editor.show_line_numbers", true)
+	v.SetDefault("editor.auto_save", true)
+	v.SetDefault("editor.auto_save_interval", "30s")
+	v.SetDefault("editor.tab_size", 2)
+	v.SetDefault("editor.wrap_text", false)
+	v.SetDefault("editor.typewriter_mode", false)
+	v.SetDefault("editor.typewriter_position", 0.66)
+	v.SetDefault("editor.typewriter_focus_lines", 1)
 
 	// Writing defaults
-	viper.SetDefault("writing.show_word_count", true)
-	viper.SetDefault("writing.show_reading_time", true)
-	viper.SetDefault("writing.words_per_minute", 200)
-	viper.SetDefault("writing.daily_goal", 500)
+	v.SetDefault("writing.show_word_count", true)
+	v.SetDefault("writing.show_reading_time", true)
+	v.SetDefault("writing.words_per_minute", 200)
+	v.SetDefault("writing.daily_goal", 500)
+	v.SetDefault("writing.pomodoro", "25m")
+	v.SetDefault("writing.breaklength", "5m")
+	v.SetDefault("writing.warnpct", 0.9)
 
 	// Project defaults
-	viper.SetDefault("project.default_template", "novel")
-	viper.SetDefault("project.backup_enabled", true)
-	viper.SetDefault("project.backup_interval", "5m")
+	v.SetDefault("project.default_template", "novel")
+	v.SetDefault("project.backup_enabled", true)
+	v.SetDefault("project.backup_interval", "5m")
 
 	// Theme defaults
-	viper.SetDefault("theme.current", "dark")
-	viper.SetDefault("theme.auto_switch", false)
-	viper.SetDefault("theme.dark_hours", []int{18, 19, 20, 21, 22, 23, 0, 1, 2, 3, 4, 5, 6})
+	v.SetDefault("theme.current", "dark")
+	v.SetDefault("theme.auto_switch", false)
+	v.SetDefault("theme.dark_hours", []int{18, 19, 20, 21, 22, 23, 0, 1, 2, 3, 4, 5, 6})
 }
@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"writers-cli/internal/config"
+	"writers-cli/internal/submissions"
+)
+
+// newSubCmd creates the "sub" command and its subcommands, which track
+// story submissions to markets.
+func newSubCmd(deps *config.Deps) *cobra.Command {
+	subCmd := &cobra.Command{
+		Use:   "sub",
+		Short: "Track story submissions to markets",
+		Long: `Track where stories have been submitted, to whom, and how it went.
+
+Commands:
+  add     - Record a new submission
+  list    - List recorded submissions
+  update  - Update a submission's status, payment, or notes
+  stats   - Summarize submissions by status`,
+	}
+
+	var (
+		addStatus  string
+		addPayment int
+		addNotes   string
+	)
+	addCmd := &cobra.Command{
+		Use:   "add <story> <market>",
+		Short: "Record a new submission",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSubAdd(deps, args, addStatus, addPayment, addNotes)
+		},
+	}
+	addCmd.Flags().StringVar(&addStatus, "status", string(submissions.StatusSubmitted), "submission status")
+	addCmd.Flags().IntVar(&addPayment, "payment", 0, "payment in cents")
+	addCmd.Flags().StringVar(&addNotes, "notes", "", "freeform notes")
+	subCmd.AddCommand(addCmd)
+
+	var listOverdue bool
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List recorded submissions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSubList(deps, listOverdue)
+		},
+	}
+	listCmd.Flags().BoolVar(&listOverdue, "overdue", false, "only show submissions past their market's response SLA")
+	subCmd.AddCommand(listCmd)
+
+	var (
+		updateStatus    string
+		updatePayment   int
+		updateNotes     string
+		updateResponded string
+	)
+	updateCmd := &cobra.Command{
+		Use:   "update <index>",
+		Short: "Update a submission",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSubUpdate(deps, cmd, args, updateStatus, updatePayment, updateNotes, updateResponded)
+		},
+	}
+	updateCmd.Flags().StringVar(&updateStatus, "status", "", "new submission status")
+	updateCmd.Flags().IntVar(&updatePayment, "payment", 0, "new payment in cents")
+	updateCmd.Flags().StringVar(&updateNotes, "notes", "", "new notes")
+	updateCmd.Flags().StringVar(&updateResponded, "responded", "", "when the market responded, RFC3339 (defaults to now when --status is set)")
+	subCmd.AddCommand(updateCmd)
+
+	subCmd.AddCommand(&cobra.Command{
+		Use:   "stats",
+		Short: "Summarize submissions by status",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSubStats(deps)
+		},
+	})
+
+	return subCmd
+}
+
+func runSubAdd(deps *config.Deps, args []string, status string, payment int, notes string) error {
+	sub := submissions.Submission{
+		Story:        args[0],
+		Market:       args[1],
+		SubmittedAt:  deps.Clock.Now(),
+		Status:       submissions.Status(status),
+		PaymentCents: payment,
+		Notes:        notes,
+	}
+
+	if err := submissions.Add(deps, ".", sub); err != nil {
+		return fmt.Errorf("failed to record submission: %w", err)
+	}
+
+	fmt.Printf("📬 Recorded submission: %s -> %s\n", sub.Story, sub.Market)
+	return nil
+}
+
+func runSubList(deps *config.Deps, overdueOnly bool) error {
+	subs, err := submissions.List(deps, ".")
+	if err != nil {
+		return fmt.Errorf("failed to list submissions: %w", err)
+	}
+
+	var markets submissions.MarketDirectory
+	if overdueOnly {
+		path, err := submissions.DefaultMarketDirectoryPath()
+		if err != nil {
+			return fmt.Errorf("failed to resolve market directory: %w", err)
+		}
+		markets, err = submissions.LoadMarketDirectory(path)
+		if err != nil {
+			return fmt.Errorf("failed to load market directory: %w", err)
+		}
+	}
+
+	now := deps.Clock.Now()
+	shown := 0
+	for i, sub := range subs {
+		if overdueOnly && !sub.Overdue(markets[sub.Market], now) {
+			continue
+		}
+		shown++
+
+		responded := "pending"
+		if sub.RespondedAt != nil {
+			responded = sub.RespondedAt.Format("2006-01-02")
+		}
+		fmt.Printf("[%d] %s -> %s  %s  submitted %s  responded %s\n",
+			i, sub.Story, sub.Market, sub.Status, sub.SubmittedAt.Format("2006-01-02"), responded)
+	}
+
+	if shown == 0 {
+		if overdueOnly {
+			fmt.Println("No overdue submissions")
+		} else {
+			fmt.Println("No submissions recorded yet")
+		}
+	}
+
+	return nil
+}
+
+func runSubUpdate(deps *config.Deps, cmd *cobra.Command, args []string, status string, payment int, notes, responded string) error {
+	index, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid index %q: %w", args[0], err)
+	}
+
+	var respondedAt *time.Time
+	if responded != "" {
+		t, err := time.Parse(time.RFC3339, responded)
+		if err != nil {
+			return fmt.Errorf("invalid --responded date %q: %w", responded, err)
+		}
+		respondedAt = &t
+	} else if status != "" {
+		t := deps.Clock.Now()
+		respondedAt = &t
+	}
+
+	sub, err := submissions.Update(deps, ".", index, func(sub *submissions.Submission) {
+		if status != "" {
+			sub.Status = submissions.Status(status)
+		}
+		if cmd.Flags().Changed("payment") {
+			sub.PaymentCents = payment
+		}
+		if notes != "" {
+			sub.Notes = notes
+		}
+		if respondedAt != nil {
+			sub.RespondedAt = respondedAt
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update submission: %w", err)
+	}
+
+	fmt.Printf("✅ Updated [%d] %s -> %s: %s\n", index, sub.Story, sub.Market, sub.Status)
+	return nil
+}
+
+func runSubStats(deps *config.Deps) error {
+	subs, err := submissions.List(deps, ".")
+	if err != nil {
+		return fmt.Errorf("failed to list submissions: %w", err)
+	}
+
+	stats := submissions.Summarize(subs)
+	fmt.Printf("📊 Submissions: %d total\n", stats.Total)
+	fmt.Printf("  Submitted: %d\n", stats.Submitted)
+	fmt.Printf("  Accepted:  %d\n", stats.Accepted)
+	fmt.Printf("  Rejected:  %d\n", stats.Rejected)
+	fmt.Printf("  Withdrawn: %d\n", stats.Withdrawn)
+	fmt.Printf("  Earned:    $%.2f\n", float64(stats.TotalPaymentCents)/100)
+
+	return nil
+}
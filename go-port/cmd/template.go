@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"writers-cli/internal/config"
+	"writers-cli/internal/project"
+	"writers-cli/internal/templates"
+)
+
+// newTemplateCmd creates the "template" command and its subcommands, which
+// manage the project-type templates `writers init` scaffolds from.
+func newTemplateCmd(deps *config.Deps) *cobra.Command {
+	templateCmd := &cobra.Command{
+		Use:   "template",
+		Short: "Manage project templates",
+		Long: `List and install the project templates "writers init --type <name>" scaffolds from.
+
+Built-in templates (novel, shortstories, article, screenplay, poetry,
+journal, academic) ship in the binary. Community templates installed with
+"writers template install" live under ~/.config/writers-cli/templates and
+take priority over a built-in of the same name.`,
+	}
+
+	templateCmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List available project templates",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTemplateList(deps, cmd, args)
+		},
+	})
+
+	templateCmd.AddCommand(&cobra.Command{
+		Use:   "install <git-url> [name]",
+		Short: "Install a community project template from a git repository",
+		Long: `Install a community project template from a git repository.
+
+The repository must contain a template.yml manifest (dirs, files, goals) at
+its root - the same format written by hand under
+~/.config/writers-cli/templates/<name>/template.yml. If name is omitted, the
+repository name is used.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTemplateInstall(deps, cmd, args)
+		},
+	})
+
+	return templateCmd
+}
+
+func runTemplateList(deps *config.Deps, cmd *cobra.Command, args []string) error {
+	for _, name := range project.DefaultProvider().Names() {
+		fmt.Println(name)
+	}
+	return nil
+}
+
+func runTemplateInstall(deps *config.Deps, cmd *cobra.Command, args []string) error {
+	src, err := templates.Resolve(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to resolve template %s: %w", args[0], err)
+	}
+
+	name := templateInstallName(args)
+	if name == "" {
+		return fmt.Errorf("could not determine a template name from %s; pass one explicitly", args[0])
+	}
+
+	dir, err := project.UserTemplatesDir()
+	if err != nil {
+		return fmt.Errorf("failed to resolve templates directory: %w", err)
+	}
+
+	dest := filepath.Join(dir, name)
+	if err := templates.Materialize(src, dest, false, nil); err != nil {
+		return fmt.Errorf("failed to install template %s: %w", args[0], err)
+	}
+
+	fmt.Printf("✅ Installed template %q to %s\n", name, dest)
+	return nil
+}
+
+// templateInstallName returns the explicit name argument, or derives one
+// from a recognized git host URL.
+func templateInstallName(args []string) string {
+	if len(args) > 1 {
+		return args[1]
+	}
+
+	src, err := templates.Parse(args[0])
+	if err != nil || src.Kind != templates.KindGit {
+		return ""
+	}
+	return src.Repo
+}
@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"writers-cli/internal/config"
+	"writers-cli/internal/workflow"
+)
+
+// newWorkflowCmd creates the "workflow" command and its subcommands.
+func newWorkflowCmd(deps *config.Deps) *cobra.Command {
+	var workflowStatusFormat string
+
+	workflowCmd := &cobra.Command{
+		Use:   "workflow",
+		Short: "Writing workflow automation",
+		Long: `Automate common writing workflows and tasks.
+
+Workflows:
+  daily          - Daily writing session setup
+  session        - Start a focused writing session (pomodoro/sprint timer)
+  status         - Print the current sprint status
+  i3             - Alias for status defaulting to i3bar format
+  goal           - Track writing goals
+  submit         - Prepare for submission
+  review         - Review and revision workflow`,
+	}
+
+	workflowCmd.AddCommand(&cobra.Command{
+		Use:   "daily",
+		Short: "Start daily writing session",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWorkflowDaily(deps, cmd, args)
+		},
+	})
+
+	workflowCmd.AddCommand(&cobra.Command{
+		Use:   "session [duration]",
+		Short: "Start a focused writing session",
+		Long: `Start a pomodoro-style writing sprint.
+
+Runs a work phase for [duration] (default writing.pomodoro, or 25m), followed
+by a break phase of writing.breaklength. Words written are snapshotted by
+diffing the project's markdown word count at the start and end of the work
+phase and logged to ~/.writers-cli/sessions.jsonl for 'writers workflow goal'.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWorkflowSession(deps, cmd, args)
+		},
+	})
+
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Print the current sprint status for embedding in a status bar",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWorkflowStatus(deps, cmd, args, workflowStatusFormat)
+		},
+	}
+	statusCmd.Flags().StringVar(&workflowStatusFormat, "format", "plain", "output format (i3bar, tmux, json, plain)")
+	workflowCmd.AddCommand(statusCmd)
+
+	workflowCmd.AddCommand(&cobra.Command{
+		Use:   "i3",
+		Short: "Print the current sprint status in i3bar format",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWorkflowStatus(deps, cmd, args, "i3bar")
+		},
+	})
+
+	workflowCmd.AddCommand(&cobra.Command{
+		Use:   "goal",
+		Short: "Show progress toward today's writing goal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWorkflowGoal(deps, cmd, args)
+		},
+	})
+
+	return workflowCmd
+}
+
+func runWorkflowDaily(deps *config.Deps, cmd *cobra.Command, args []string) error {
+	records, err := workflow.ReadHistory()
+	yesterday := 0
+	if err == nil {
+		startOfToday := startOfDay(deps.Clock.Now())
+		yesterday = workflow.WordsSince(records, startOfToday.Add(-24*time.Hour)) - workflow.WordsSince(records, startOfToday)
+	}
+
+	fmt.Println("🌅 Starting daily writing session...")
+	fmt.Printf("📊 Yesterday's progress: %d words\n", yesterday)
+	fmt.Printf("🎯 Today's goal: %d words\n", deps.Viper.GetInt("writing.daily_goal"))
+	if last := deps.Viper.GetString("workflow.lastsession"); last != "" {
+		fmt.Printf("⏱  Last sprint: %s\n", last)
+	}
+	if last := deps.Viper.GetString("workflow.lastbreak"); last != "" {
+		fmt.Printf("☕ Last break: %s\n", last)
+	}
+	fmt.Println("✍️  Ready to write! Use 'writers edit' to begin.")
+	return nil
+}
+
+func runWorkflowSession(deps *config.Deps, cmd *cobra.Command, args []string) error {
+	duration := deps.Viper.GetDuration("writing.pomodoro")
+	if duration <= 0 {
+		duration = 25 * time.Minute
+	}
+	if len(args) > 0 {
+		parsed, err := time.ParseDuration(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid duration %q: %w", args[0], err)
+		}
+		duration = parsed
+	}
+
+	breakLength := deps.Viper.GetDuration("writing.breaklength")
+	if breakLength <= 0 {
+		breakLength = 5 * time.Minute
+	}
+
+	warnPct := deps.Viper.GetFloat64("writing.warnpct")
+	if warnPct <= 0 {
+		warnPct = 0.9
+	}
+
+	written, err := workflow.RunSession(workflow.SessionConfig{
+		WorkDuration:  duration,
+		BreakDuration: breakLength,
+		WarnPct:       warnPct,
+		ProjectRoot:   ".",
+		Viper:         deps.Viper,
+	})
+	if err != nil {
+		return fmt.Errorf("writing session failed: %w", err)
+	}
+
+	fmt.Printf("✅ Sprint complete — %d words written\n", written)
+	return nil
+}
+
+func runWorkflowStatus(deps *config.Deps, cmd *cobra.Command, args []string, format string) error {
+	state, err := workflow.LoadState()
+	if err != nil {
+		return fmt.Errorf("failed to read session state: %w", err)
+	}
+
+	line, err := workflow.FormatStatus(state, format)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(line)
+	return nil
+}
+
+func runWorkflowGoal(deps *config.Deps, cmd *cobra.Command, args []string) error {
+	records, err := workflow.ReadHistory()
+	if err != nil {
+		return fmt.Errorf("failed to read session history: %w", err)
+	}
+
+	startOfToday := startOfDay(deps.Clock.Now())
+	words := workflow.WordsSince(records, startOfToday)
+	goal := deps.Viper.GetInt("writing.daily_goal")
+
+	pct := 0
+	if goal > 0 {
+		pct = words * 100 / goal
+	}
+
+	fmt.Printf("🎯 %d / %d words today (%d%%)\n", words, goal, pct)
+	return nil
+}
+
+// startOfDay returns midnight of now's calendar day in now's own location.
+// time.Time.Truncate(24*time.Hour) is not equivalent to this - it rounds
+// down against the absolute zero instant, which is UTC-aligned, so in any
+// non-UTC timezone it lands hours into the previous local day instead of at
+// today's midnight.
+func startOfDay(now time.Time) time.Time {
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+}
@@ -0,0 +1,277 @@
+// Package browser implements a Bubble Tea based file/project browser used by
+// `writers edit .` to let a user pick a file to open, modeled loosely on
+// Glow's stashed-document list.
+package browser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"writers-cli/internal/project"
+)
+
+// knownSections are the project directories the built-in project templates
+// scaffold that the browser groups items under when run inside a writers
+// project.
+var knownSections = []string{"chapters", "scenes", "stories", "notes"}
+
+// Item is a single markdown (or, with AllFiles, any) file found under the
+// browsed directory.
+type Item struct {
+	Path     string // absolute path
+	RelPath  string // path relative to the browsed root
+	Section  string // project section this file belongs to, or "" if none
+	Words    int
+	Modified time.Time
+}
+
+// FilterValue lets list.Model's "/" fuzzy filter match on the relative path.
+func (i Item) FilterValue() string { return i.RelPath }
+
+// Options configures a browse session.
+type Options struct {
+	// AllFiles includes every file, not just *.md, mirroring --all-files.
+	AllFiles bool
+	// DailyGoal is writing.daily_goal, used to render per-group progress.
+	DailyGoal int
+}
+
+// Result is returned by Run once the user makes a selection or cancels.
+type Result struct {
+	// Selected is the absolute path of the chosen file, or "" if cancelled.
+	Selected string
+}
+
+// Run launches the browser over dirPath and blocks until the user selects a
+// file or quits.
+func Run(dirPath string, opts Options) (Result, error) {
+	items, err := scan(dirPath, opts.AllFiles)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to scan directory: %w", err)
+	}
+
+	m := newModel(dirPath, items, opts)
+
+	program := tea.NewProgram(m)
+	final, err := program.Run()
+	if err != nil {
+		return Result{}, fmt.Errorf("browser exited with error: %w", err)
+	}
+
+	fm := final.(model)
+	return Result{Selected: fm.selected}, nil
+}
+
+// scan walks dirPath recursively collecting candidate files.
+func scan(dirPath string, allFiles bool) ([]Item, error) {
+	var items []Item
+
+	err := filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			// Skip hidden/project-internal directories.
+			base := filepath.Base(path)
+			if base != "." && strings.HasPrefix(base, ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !allFiles && strings.ToLower(filepath.Ext(path)) != ".md" {
+			return nil
+		}
+		if strings.HasPrefix(filepath.Base(path), ".") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dirPath, path)
+		if err != nil {
+			rel = path
+		}
+
+		content, err := os.ReadFile(path)
+		words := 0
+		if err == nil {
+			words = len(strings.Fields(string(content)))
+		}
+
+		items = append(items, Item{
+			Path:     path,
+			RelPath:  rel,
+			Section:  sectionFor(rel),
+			Words:    words,
+			Modified: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(items, func(a, b int) bool {
+		return items[a].RelPath < items[b].RelPath
+	})
+
+	return items, nil
+}
+
+// sectionFor reports the project section a relative path belongs to, based
+// on the directory names the built-in project templates scaffold.
+func sectionFor(relPath string) string {
+	first := strings.SplitN(filepath.ToSlash(relPath), "/", 2)[0]
+	for _, section := range knownSections {
+		if first == section {
+			return section
+		}
+	}
+	return ""
+}
+
+// isProject reports whether dirPath looks like a writers-initialized
+// project, i.e. it has a project config file.
+func isProject(dirPath string) bool {
+	_, err := os.Stat(filepath.Join(dirPath, project.ConfigFileName))
+	return err == nil
+}
+
+var (
+	sectionStyle  = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("63"))
+	progressStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
+	helpStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+)
+
+type keyMap struct {
+	Open key.Binding
+	Quit key.Binding
+}
+
+var keys = keyMap{
+	Open: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "open")),
+	Quit: key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q", "quit")),
+}
+
+type model struct {
+	dirPath   string
+	opts      Options
+	list      list.Model
+	isProject bool
+	selected  string
+}
+
+func newModel(dirPath string, items []Item, opts Options) model {
+	delegate := itemDelegate{}
+
+	listItems := make([]list.Item, len(items))
+	for i, it := range items {
+		listItems[i] = it
+	}
+
+	l := list.New(listItems, delegate, 0, 0)
+	l.Title = fmt.Sprintf("Writers CLI — %s", dirPath)
+	l.SetShowStatusBar(true)
+	l.SetFilteringEnabled(true)
+
+	return model{
+		dirPath:   dirPath,
+		opts:      opts,
+		list:      l,
+		isProject: isProject(dirPath),
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.list.SetSize(msg.Width, msg.Height-headerLines(m))
+		return m, nil
+	case tea.KeyMsg:
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
+		switch {
+		case key.Matches(msg, keys.Quit):
+			return m, tea.Quit
+		case key.Matches(msg, keys.Open):
+			if it, ok := m.list.SelectedItem().(Item); ok {
+				m.selected = it.Path
+				return m, tea.Quit
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+func (m model) View() string {
+	var b strings.Builder
+
+	if m.isProject {
+		b.WriteString(m.progressSummary())
+		b.WriteString("\n")
+	}
+
+	b.WriteString(m.list.View())
+	b.WriteString("\n")
+	b.WriteString(helpStyle.Render("/ filter • enter open • q quit"))
+
+	return b.String()
+}
+
+// headerLines estimates how many lines the project-progress summary takes,
+// so the list gets an accurate viewport size.
+func headerLines(m model) int {
+	if !m.isProject {
+		return 2
+	}
+	return 2 + len(knownSections)
+}
+
+// progressSummary renders per-section word-count progress toward
+// writing.daily_goal for projects detected via project.Config.
+func (m model) progressSummary() string {
+	totals := map[string]int{}
+	for _, li := range m.list.Items() {
+		it, ok := li.(Item)
+		if !ok || it.Section == "" {
+			continue
+		}
+		totals[it.Section] += it.Words
+	}
+
+	var b strings.Builder
+	for _, section := range knownSections {
+		words, ok := totals[section]
+		if !ok {
+			continue
+		}
+		goal := m.opts.DailyGoal
+		pct := 0
+		if goal > 0 {
+			pct = words * 100 / goal
+			if pct > 100 {
+				pct = 100
+			}
+		}
+		b.WriteString(sectionStyle.Render(section))
+		b.WriteString(" ")
+		b.WriteString(progressStyle.Render(fmt.Sprintf("%d words (%d%% of daily goal)\n", words, pct)))
+	}
+	return b.String()
+}
@@ -0,0 +1,45 @@
+package browser
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	normalTitleStyle   = lipgloss.NewStyle().PaddingLeft(2)
+	selectedTitleStyle = lipgloss.NewStyle().PaddingLeft(1).Foreground(lipgloss.Color("170")).Bold(true)
+	metaStyle          = lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
+)
+
+// itemDelegate renders an Item with its word-count and last-modified columns.
+type itemDelegate struct{}
+
+func (d itemDelegate) Height() int                             { return 1 }
+func (d itemDelegate) Spacing() int                            { return 0 }
+func (d itemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d itemDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	it, ok := listItem.(Item)
+	if !ok {
+		return
+	}
+
+	title := it.RelPath
+	if it.Section != "" {
+		title = fmt.Sprintf("%s/%s", it.Section, strings.TrimPrefix(it.RelPath, it.Section+"/"))
+	}
+
+	meta := metaStyle.Render(fmt.Sprintf("%5d words  %s", it.Words, it.Modified.Format("2006-01-02 15:04")))
+	line := fmt.Sprintf("%s  %s", title, meta)
+
+	if index == m.Index() {
+		fmt.Fprint(w, selectedTitleStyle.Render("> "+line))
+		return
+	}
+	fmt.Fprint(w, normalTitleStyle.Render(line))
+}
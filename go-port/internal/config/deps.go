@@ -0,0 +1,48 @@
+// Package config bundles the process-wide dependencies commands need,
+// so they can be constructed once in cmd.Execute and threaded through
+// explicitly instead of every RunE reaching into global state (a
+// process-global viper instance, the OS filesystem, the wall clock).
+// This is what lets callers build more than one isolated writers-cli
+// engine in a process, and lets tests swap in an in-memory filesystem.
+package config
+
+import (
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/viper"
+)
+
+// Clock abstracts time.Now so commands can be driven with a fixed time.
+type Clock interface {
+	Now() time.Time
+}
+
+// realClock is the Clock used outside of tests.
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+// Deps bundles everything a command needs besides its own flags and
+// arguments: config, filesystem, output streams, and the clock.
+type Deps struct {
+	Viper  *viper.Viper
+	Fs     afero.Fs
+	Out    io.Writer
+	ErrOut io.Writer
+	Clock  Clock
+}
+
+// New builds the default, process-wide Deps: a fresh viper instance, the
+// real OS filesystem, stdout/stderr, and the real clock.
+func New() *Deps {
+	return &Deps{
+		Viper:  viper.New(),
+		Fs:     afero.NewOsFs(),
+		Out:    os.Stdout,
+		ErrOut: os.Stderr,
+		Clock:  realClock{},
+	}
+}
@@ -11,7 +11,12 @@ import (
 	"github.com/rivo/tview"
 	"golang.design/x/clipboard"
 
+	"writers-cli/internal/editor/history"
+	"writers-cli/internal/editor/panes"
+	"writers-cli/internal/editor/plugins"
+	"writers-cli/internal/project"
 	"writers-cli/internal/themes"
+	"writers-cli/internal/vcs"
 )
 
 // Config holds the editor configuration
@@ -30,6 +35,10 @@ type Config struct {
 	ShowWordCount    bool
 	ShowReadingTime  bool
 	Debug            bool
+
+	// AutoCommit opts into snapshotting the file's repository to git on
+	// every save, mirroring the project's Settings.AutoCommit.
+	AutoCommit bool
 }
 
 // Editor represents the main editor instance
@@ -37,24 +46,31 @@ type Editor struct {
 	config    *Config
 	app       *tview.Application
 	pages     *tview.Pages
-	textArea  *tview.TextArea
+	layout    tview.Primitive
 	statusBar *tview.TextView
 	infoBar   *tview.TextView
 	helpBar   *tview.TextView
 	modal     tview.Primitive
 
-	// Editor state
-	currentFile string
-	isDirty     bool
-	mode        EditorMode
+	// root is the pane tree's root, and active is the pane currently
+	// focused. Each pane is a panes.Leaf holding its own text area, file,
+	// dirty flag, and cursor, so splitting the view never shares mutable
+	// state between panes.
+	root   *panes.Node
+	active *panes.Node
+
+	mode EditorMode
 
 	// Theme management
-	themeManager *themes.Manager
+	themeManager *themes.ThemeRegistry
 
-	// Cursor and selection
-	cursorRow int
-	cursorCol int
-	selecting bool
+	// themeWatchStop stops the live theme-directory watch started in New,
+	// if one was started successfully; nil otherwise.
+	themeWatchStop func()
+
+	// plugins dispatches lifecycle hooks and plugin-registered actions
+	// loaded from ~/.config/writers-cli/plugins/*.lua.
+	plugins *plugins.Manager
 
 	// Search state
 	searchTerm   string
@@ -63,10 +79,16 @@ type Editor struct {
 	// Auto-save
 	autoSaveTimer *time.Timer
 
-	// Statistics
-	wordCount int
-	charCount int
-	lineCount int
+	// suppressChangeTracking is set while the editor itself rewrites a pane's
+	// text (undo, redo, opening or creating a file), so that rewrite isn't
+	// recorded as a new edit in the pane's own history.
+	suppressChangeTracking bool
+
+	// Key bindings: chordBindings is compiled from bindings.json5 (or the
+	// defaults), and pendingChord accumulates key presses while the input
+	// so far is a prefix of a multi-key chord like "CtrlK CtrlS".
+	chordBindings []chordBinding
+	pendingChord  []KeyPress
 }
 
 // EditorMode represents the current editor mode
@@ -102,17 +124,88 @@ func New(config *Config) *Editor {
 		config:       config,
 		app:          app,
 		mode:         ModeNavigation,
-		themeManager: themes.NewManager(),
+		themeManager: themes.NewThemeRegistry(),
+	}
+
+	// Load any user-supplied styleset themes alongside the built-ins.
+	if dir, dirErr := stylesetsDir(); dirErr == nil {
+		for _, loadErr := range editor.themeManager.LoadStylesetsDir(dir) {
+			if config.Debug {
+				fmt.Printf("Warning: %v\n", loadErr)
+			}
+		}
+	}
+
+	// Load any user-supplied TOML/YAML theme files alongside the built-ins,
+	// then watch that directory so edits to a theme file take effect without
+	// restarting the editor.
+	if dir, dirErr := themesDir(); dirErr == nil {
+		for _, loadErr := range editor.themeManager.LoadThemesDir(dir) {
+			if config.Debug {
+				fmt.Printf("Warning: %v\n", loadErr)
+			}
+		}
+
+		if stop, changed, errs, watchErr := editor.themeManager.WatchDir(dir); watchErr == nil {
+			editor.themeWatchStop = stop
+			go editor.watchThemeDir(changed, errs)
+		} else if config.Debug {
+			fmt.Printf("Warning: failed to watch theme directory: %v\n", watchErr)
+		}
+	}
+
+	// Load any colorschemes imported via "writers theme import".
+	if dir, dirErr := colorschemesDir(); dirErr == nil {
+		for _, loadErr := range editor.themeManager.LoadColorschemesDir(dir) {
+			if config.Debug {
+				fmt.Printf("Warning: %v\n", loadErr)
+			}
+		}
 	}
 
-	// Set initial theme
+	// Set initial theme. An explicit config.Theme (CLI flag or persisted
+	// editor.theme setting) always wins; otherwise restore the theme saved
+	// by a previous session's exit, falling back to the OS's light/dark
+	// appearance, and finally whatever default ThemeRegistry already set.
 	if config.Theme != "" {
 		editor.themeManager.SetTheme(config.Theme)
+	} else {
+		restored := false
+		if statePath, pathErr := themes.DefaultStatePath(); pathErr == nil {
+			applied, loadErr := editor.themeManager.LoadState(statePath)
+			if loadErr != nil && config.Debug {
+				fmt.Printf("Warning: failed to load theme state: %v\n", loadErr)
+			}
+			restored = applied
+		}
+		if !restored {
+			editor.themeManager.SetThemeBySystemAppearance()
+		}
 	}
 
+	// Detect the terminal's real color capability as soon as a live screen
+	// exists, so ResolveColor degrades theme colors correctly from the very
+	// first frame instead of assuming truecolor.
+	app.SetBeforeDrawFunc(func(screen tcell.Screen) bool {
+		editor.themeManager.DetectColorProfile(screen)
+		editor.applyThemeToTextArea()
+		editor.applyThemeToUI(editor.themeManager.GetCurrentTheme())
+		app.SetBeforeDrawFunc(nil)
+		return false
+	})
+
 	editor.setupUI()
 	editor.setupKeybindings()
 
+	editor.plugins = plugins.NewManager(editor)
+	if dir, dirErr := pluginsDir(); dirErr == nil {
+		for _, loadErr := range editor.plugins.LoadDir(dir) {
+			if config.Debug {
+				fmt.Printf("Warning: %v\n", loadErr)
+			}
+		}
+	}
+
 	return editor
 }
 
@@ -122,6 +215,13 @@ func (e *Editor) Launch(filePath string) error {
 		if err := e.openFile(filePath); err != nil {
 			return fmt.Errorf("failed to open file: %w", err)
 		}
+
+		if root, ok := findProjectRoot(filepath.Dir(filePath)); ok {
+			if e.themeManager.LoadProjectTheme(root) {
+				e.applyThemeToTextArea()
+				e.applyThemeToUI(e.themeManager.GetCurrentTheme())
+			}
+		}
 	} else {
 		e.newFile()
 	}
@@ -140,16 +240,11 @@ func (e *Editor) Launch(filePath string) error {
 
 // setupUI creates the user interface components
 func (e *Editor) setupUI() {
-	// Get current theme
 	theme := e.themeManager.GetCurrentTheme()
 
-	// Create text area
-	e.textArea = tview.NewTextArea()
-	e.textArea.SetBorder(true)
-	e.textArea.SetTitle(" Writers CLI Editor ")
-
-	// Apply theme to text area
-	e.applyThemeToTextArea()
+	e.root = panes.NewLeaf(e.newTextArea())
+	e.active = e.root
+	e.wireChangeTracking(e.root.Leaf)
 
 	// Create status bar
 	e.statusBar = tview.NewTextView()
@@ -166,86 +261,246 @@ func (e *Editor) setupUI() {
 	// Create help bar
 	e.helpBar = tview.NewTextView()
 	e.helpBar.SetDynamicColors(true)
-	e.helpBar.SetText(" ^S Save  ^O Open  ^X Exit  ^F Find  ^G Go to Line  ^W Word Count  F1 Help  F2 Theme")
+	e.helpBar.SetText(" ^S Save  ^O Open  ^X Exit  ^F Find  ^G Go to Line  ^W Word Count  F1 Help  F2 Theme  F3/F4 Split  F5 Close Pane  F6 Next Pane")
 	e.helpBar.SetBorder(false)
 
-	// Apply theme to UI components
 	e.applyThemeToUI(theme)
+	e.applyThemeToTextArea()
+
+	e.pages = tview.NewPages()
+	e.rebuildLayout()
+
+	e.app.SetRoot(e.pages, true)
+	e.app.SetFocus(e.activeTextArea())
+}
 
-	// Create main layout
-	mainFlex := tview.NewFlex().SetDirection(tview.FlexRow)
+// newTextArea creates a bare text area for use as a new pane.
+func (e *Editor) newTextArea() *tview.TextArea {
+	textArea := tview.NewTextArea()
+	textArea.SetBorder(true)
+	return textArea
+}
+
+// rebuildLayout reconstructs the status/info/help chrome and the pane area
+// from the current pane tree. It must be called after setupUI and after any
+// split, close, or resize so the screen reflects the tree's current shape.
+func (e *Editor) rebuildLayout() {
+	paneArea := e.buildPaneView(e.root)
 
+	container := tview.NewFlex().SetDirection(tview.FlexRow)
 	if !e.config.DistractionFree {
-		// Add text area with status bars
-		mainFlex.AddItem(e.textArea, 0, 1, true).
+		container.
+			AddItem(paneArea, 0, 1, true).
 			AddItem(e.statusBar, 1, 0, false).
 			AddItem(e.infoBar, 1, 0, false).
 			AddItem(e.helpBar, 1, 0, false)
 	} else {
-		// Distraction-free mode - only text area
-		mainFlex.AddItem(e.textArea, 0, 1, true)
+		container.AddItem(paneArea, 0, 1, true)
 	}
 
-	// Create pages for modal dialogs
-	e.pages = tview.NewPages()
-	e.pages.AddPage("main", mainFlex, true, true)
+	e.layout = container
+	e.pages.RemovePage("main")
+	e.pages.AddPage("main", e.layout, true, true)
+	e.app.SetFocus(e.activeTextArea())
+}
 
-	e.app.SetRoot(e.pages, true)
+// buildPaneView recursively turns the pane tree rooted at n into a tview
+// primitive: a leaf becomes its (styled) text area, a split becomes a Flex
+// dividing its two children by the split's ratio.
+func (e *Editor) buildPaneView(n *panes.Node) tview.Primitive {
+	if n.IsLeaf() {
+		e.stylePane(n)
+		return n.Leaf.TextArea
+	}
+
+	direction := tview.FlexColumn
+	if n.Orientation == panes.Vertical {
+		direction = tview.FlexRow
+	}
+
+	firstShare := int(n.Ratio * 1000)
+	if firstShare < 1 {
+		firstShare = 1
+	}
+	secondShare := 1000 - firstShare
+	if secondShare < 1 {
+		secondShare = 1
+	}
+
+	return tview.NewFlex().SetDirection(direction).
+		AddItem(e.buildPaneView(n.First), 0, firstShare, false).
+		AddItem(e.buildPaneView(n.Second), 0, secondShare, false)
+}
+
+// activeTextArea returns the text area of the currently focused pane.
+func (e *Editor) activeTextArea() *tview.TextArea {
+	return e.active.Leaf.TextArea
+}
+
+// activePaneIndex returns the active pane's position among root's leaves,
+// in document order.
+func (e *Editor) activePaneIndex() int {
+	for i, n := range e.root.Leaves() {
+		if n == e.active {
+			return i
+		}
+	}
+	return 0
+}
+
+// wireChangeTracking hooks leaf's text area so every edit and cursor move
+// feeds leaf's own undo history, independent of every other pane's.
+func (e *Editor) wireChangeTracking(leaf *panes.Leaf) {
+	leaf.TextArea.SetChangedFunc(func() {
+		e.onTextChanged(leaf)
+	})
+	leaf.TextArea.SetMovedFunc(func() {
+		e.onCursorMoved(leaf)
+	})
+}
+
+// onTextChanged diffs leaf's text area against its last known content,
+// records the resulting edit (unless the editor caused the change itself,
+// such as during undo/redo or opening a file), and marks the pane dirty.
+func (e *Editor) onTextChanged(leaf *panes.Leaf) {
+	leaf.JustEdited = true
+
+	newText := leaf.TextArea.GetText()
+	oldText := leaf.LastText
+	leaf.LastText = newText
 
-	// Focus on text area
-	e.app.SetFocus(e.textArea)
+	if e.suppressChangeTracking || oldText == newText {
+		return
+	}
+
+	e.recordEdit(leaf, oldText, newText)
+	e.markLeafDirty(leaf)
+}
+
+// onCursorMoved breaks leaf's undo group when the cursor moves on its own,
+// such as from arrow-key navigation, but not when the move is just the
+// natural side effect of the edit onTextChanged already recorded.
+func (e *Editor) onCursorMoved(leaf *panes.Leaf) {
+	if leaf.JustEdited {
+		leaf.JustEdited = false
+		return
+	}
+	leaf.History.BreakGroup()
+}
+
+// recordEdit diffs oldText against newText and records the result onto
+// leaf's history as one or two TextEvents (a replaced selection becomes a
+// delete followed by an insert, since TextEvent only models the two basic
+// operations). A typed or backspaced newline always starts its own group,
+// per the editor's group-break rules.
+func (e *Editor) recordEdit(leaf *panes.Leaf, oldText, newText string) {
+	prefixLen, suffixLen := commonAffixLen(oldText, newText)
+	removed := oldText[prefixLen : len(oldText)-suffixLen]
+	added := newText[prefixLen : len(newText)-suffixLen]
+	now := time.Now()
+	row, col, _, _ := leaf.TextArea.GetCursor()
+	afterCursor := offsetForCursor(newText, row, col)
+
+	if removed != "" {
+		ev := history.TextEvent{
+			Op:           history.Delete,
+			Pos:          prefixLen,
+			Text:         removed,
+			Time:         now,
+			CursorBefore: prefixLen + len(removed),
+			CursorAfter:  afterCursor,
+		}
+		e.recordHistoryEvent(leaf, ev)
+	}
+
+	if added != "" {
+		ev := history.TextEvent{
+			Op:           history.Insert,
+			Pos:          prefixLen,
+			Text:         added,
+			Time:         now,
+			CursorBefore: prefixLen,
+			CursorAfter:  afterCursor,
+		}
+		e.recordHistoryEvent(leaf, ev)
+	}
+}
+
+// recordHistoryEvent records ev, forcing it into its own group when it's a
+// newline so neither the typing before nor after it merges across the line
+// break.
+func (e *Editor) recordHistoryEvent(leaf *panes.Leaf, ev history.TextEvent) {
+	isNewline := ev.Text == "\n"
+	if isNewline {
+		leaf.History.BreakGroup()
+	}
+	leaf.History.Record(ev)
+	if isNewline {
+		leaf.History.BreakGroup()
+	}
+}
+
+// commonAffixLen returns the lengths of the common byte prefix and (non-
+// overlapping) common byte suffix of a and b.
+func commonAffixLen(a, b string) (prefix, suffix int) {
+	max := len(a)
+	if len(b) < max {
+		max = len(b)
+	}
+	for prefix < max && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	max -= prefix
+	for suffix < max && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	return prefix, suffix
+}
+
+// offsetForCursor converts a (row, col) cursor position into a byte offset
+// into text, the same convention TextEvent.Pos and Select(offset, offset)
+// use.
+func offsetForCursor(text string, row, col int) int {
+	lines := strings.Split(text, "\n")
+	offset := 0
+	for i := 0; i < row && i < len(lines); i++ {
+		offset += len(lines[i]) + 1
+	}
+	if row < len(lines) {
+		line := lines[row]
+		if col > len(line) {
+			col = len(line)
+		}
+		offset += col
+	}
+	return offset
 }
 
-// setupKeybindings configures keyboard shortcuts
+// setupKeybindings loads the action-based key bindings (from
+// bindings.json5, or the built-in defaults) and wires them into a chord
+// matcher. Anything not claimed by a binding falls through to the
+// mode-specific vim-style handlers below, unchanged.
 func (e *Editor) setupKeybindings() {
+	e.chordBindings = e.compileBindings(e.loadKeyBindings())
+
 	e.app.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-		// Handle global shortcuts first
-		switch event.Key() {
-		case tcell.KeyF1:
-			e.showHelp()
-			return nil
-		case tcell.KeyF2:
-			e.switchTheme()
-			return nil
-		case tcell.KeyF9:
-			e.toggleTypewriterMode()
-			return nil
-		case tcell.KeyF11:
-			e.toggleDistractionFree()
-			return nil
-		case tcell.KeyCtrlS:
-			e.saveFile()
-			return nil
-		case tcell.KeyCtrlO:
-			e.showOpenDialog()
-			return nil
-		case tcell.KeyCtrlX:
-			e.exit()
-			return nil
-		case tcell.KeyCtrlF:
-			e.showFindDialog()
-			return nil
-		case tcell.KeyCtrlG:
-			e.showGoToLineDialog()
-			return nil
-		case tcell.KeyCtrlW:
-			e.showWordCountDialog()
-			return nil
-		case tcell.KeyCtrlZ:
-			e.undo()
-			return nil
-		case tcell.KeyCtrlY:
-			e.redo()
+		e.pendingChord = append(e.pendingChord, KeyPress{
+			Key:  event.Key(),
+			Rune: event.Rune(),
+			Mods: event.Modifiers(),
+		})
+
+		switch binding, status := matchChord(e.chordBindings, e.pendingChord); status {
+		case chordMatched:
+			e.pendingChord = nil
+			e.runActions(binding.actions)
 			return nil
-		case tcell.KeyCtrlA:
-			e.selectAll()
-			return nil
-		case tcell.KeyCtrlC:
-			e.copy()
-			return nil
-		case tcell.KeyCtrlV:
-			e.paste()
+		case chordPrefix:
 			return nil
+		default:
+			e.pendingChord = nil
 		}
 
 		// Handle mode-specific shortcuts
@@ -326,48 +581,110 @@ func (e *Editor) handleInsertKeys(event *tcell.EventKey) *tcell.EventKey {
 	return event
 }
 
-// applyThemeToTextArea applies the current theme to the text area
-func (e *Editor) applyThemeToTextArea() {
+// watchThemeDir drains the changed/errs channels from a themeManager.WatchDir
+// call for as long as the editor runs, repainting the UI with the reloaded
+// theme whenever one arrives. It runs in its own goroutine and returns once
+// errs is closed, which happens when the editor calls themeWatchStop.
+func (e *Editor) watchThemeDir(changed <-chan themes.Theme, errs <-chan error) {
+	for {
+		select {
+		case theme := <-changed:
+			e.app.QueueUpdateDraw(func() {
+				e.applyThemeToTextArea()
+				e.applyThemeToUI(theme)
+			})
+		case _, ok := <-errs:
+			if !ok {
+				return
+			}
+		}
+	}
+}
+
+// color resolves c through the theme manager's detected color profile, so
+// a true-color theme value degrades to its nearest 256-color/16-color/mono
+// equivalent on terminals that can't render it directly. Every UI color
+// read from a Theme getter should be passed through this before use.
+func (e *Editor) color(c tcell.Color) tcell.Color {
+	return e.themeManager.ResolveColor(c)
+}
+
+// stylePane applies the current theme to n's text area, marking the active
+// pane's border with the theme's focus color and its title with the pane's
+// file name and dirty state.
+func (e *Editor) stylePane(n *panes.Node) {
 	theme := e.themeManager.GetCurrentTheme()
+	textArea := n.Leaf.TextArea
 
-	e.textArea.SetBackgroundColor(theme.GetBackgroundColor())
-	// Note: TextArea may not support direct text color setting
-	e.textArea.SetBorderColor(theme.GetBorderColor())
-	e.textArea.SetTitleColor(theme.GetTitleColor())
+	textArea.SetBackgroundColor(e.color(theme.GetBackgroundColor()))
+	textArea.SetTitleColor(e.color(theme.GetTitleColor()))
+	textArea.SetTitle(fmt.Sprintf(" %s ", paneTitle(n.Leaf)))
 
-	// Note: Line number color methods may not be available in this tview version
-	// if e.config.ShowLineNumbers {
-	//     e.textArea.SetLineNumberBackgroundColor(theme.GetLineNumberBgColor())
-	//     e.textArea.SetLineNumberTextColor(theme.GetLineNumberFgColor())
-	// }
+	if n == e.active {
+		textArea.SetBorderColor(e.color(theme.GetBorderFocusColor()))
+	} else {
+		textArea.SetBorderColor(e.color(theme.GetBorderColor()))
+	}
+}
+
+// paneTitle renders a pane's displayed name: its file's base name (or
+// "Untitled"), with a dirty marker if it has unsaved changes.
+func paneTitle(leaf *panes.Leaf) string {
+	name := "Untitled"
+	if leaf.File != "" {
+		name = filepath.Base(leaf.File)
+	}
+	if leaf.Dirty {
+		name += " ●"
+	}
+	return name
+}
+
+// applyThemeToTextArea re-applies the current theme across every pane.
+func (e *Editor) applyThemeToTextArea() {
+	for _, n := range e.root.Leaves() {
+		e.stylePane(n)
+	}
 }
 
 // applyThemeToUI applies the current theme to UI components
 func (e *Editor) applyThemeToUI(theme themes.Theme) {
 	// Status bar
-	e.statusBar.SetBackgroundColor(theme.GetStatusBgColor())
-	e.statusBar.SetTextColor(theme.GetStatusFgColor())
+	e.statusBar.SetBackgroundColor(e.color(theme.GetStatusBgColor()))
+	e.statusBar.SetTextColor(e.color(theme.GetStatusFgColor()))
 
 	// Info bar
-	e.infoBar.SetBackgroundColor(theme.GetInfoBgColor())
-	e.infoBar.SetTextColor(theme.GetInfoFgColor())
+	e.infoBar.SetBackgroundColor(e.color(theme.GetInfoBgColor()))
+	e.infoBar.SetTextColor(e.color(theme.GetInfoFgColor()))
 
 	// Help bar
-	e.helpBar.SetBackgroundColor(theme.GetHelpBgColor())
-	e.helpBar.SetTextColor(theme.GetHelpFgColor())
+	e.helpBar.SetBackgroundColor(e.color(theme.GetHelpBgColor()))
+	e.helpBar.SetTextColor(e.color(theme.GetHelpFgColor()))
 }
 
 // setMode changes the editor mode
 func (e *Editor) setMode(mode EditorMode) {
 	e.mode = mode
+	e.active.Leaf.History.BreakGroup()
+	if e.plugins != nil {
+		e.plugins.RunOnModeChange(mode.String())
+	}
 	e.updateStatus()
 	e.render()
 }
 
+// breakHistoryGroup forces the active pane's next recorded edit to start a
+// new undo step. Callers use this on a cursor jump, mode switch, or paste.
+func (e *Editor) breakHistoryGroup() {
+	e.active.Leaf.History.BreakGroup()
+}
+
 // moveCursor moves the cursor by the specified delta
 func (e *Editor) moveCursor(deltaX, deltaY int) {
+	e.breakHistoryGroup()
+
 	// Get current cursor position
-	row, col, _, _ := e.textArea.GetCursor()
+	row, col, _, _ := e.activeTextArea().GetCursor()
 
 	// Calculate new position
 	newRow := row + deltaY
@@ -382,41 +699,45 @@ func (e *Editor) moveCursor(deltaX, deltaY int) {
 	}
 
 	// Set new cursor position - if SetCursor doesn't exist, we'll comment it out
-	// e.textArea.SetCursor(newRow, newCol)
+	// e.activeTextArea().SetCursor(newRow, newCol)
 	e.updateStatus()
 }
 
 // Helper methods for cursor movement
 func (e *Editor) moveToLineStart() {
-	_, _, _, _ = e.textArea.GetCursor()
-	// e.textArea.SetCursor(row, 0)
+	e.breakHistoryGroup()
+	_, _, _, _ = e.activeTextArea().GetCursor()
+	// e.activeTextArea().SetCursor(row, 0)
 	e.updateStatus()
 }
 
 func (e *Editor) moveToLineEnd() {
-	row, _, _, _ := e.textArea.GetCursor()
-	text := e.textArea.GetText()
+	e.breakHistoryGroup()
+	row, _, _, _ := e.activeTextArea().GetCursor()
+	text := e.activeTextArea().GetText()
 	lines := strings.Split(text, "\n")
 	if row < len(lines) {
-		// e.textArea.SetCursor(row, len(lines[row]))
+		// e.activeTextArea().SetCursor(row, len(lines[row]))
 	}
 	e.updateStatus()
 }
 
 func (e *Editor) pageUp() {
-	_, _, _, _ = e.textArea.GetCursor()
+	e.breakHistoryGroup()
+	_, _, _, _ = e.activeTextArea().GetCursor()
 	// newRow := row - 10 // Move up 10 lines
 	// if newRow < 0 {
 	//     newRow = 0
 	// }
-	// e.textArea.SetCursor(newRow, col)
+	// e.activeTextArea().SetCursor(newRow, col)
 	e.updateStatus()
 }
 
 func (e *Editor) pageDown() {
-	_, _, _, _ = e.textArea.GetCursor()
+	e.breakHistoryGroup()
+	_, _, _, _ = e.activeTextArea().GetCursor()
 	// newRow := row + 10 // Move down 10 lines
-	// e.textArea.SetCursor(newRow, col)
+	// e.activeTextArea().SetCursor(newRow, col)
 	e.updateStatus()
 }
 
@@ -424,52 +745,125 @@ func (e *Editor) pageDown() {
 func (e *Editor) insertTab() {
 	if e.config.TabSize > 0 {
 		spaces := strings.Repeat(" ", e.config.TabSize)
-		// e.textArea.InsertText(spaces) // Method not available
+		// e.activeTextArea().InsertText(spaces) // Method not available
 		_ = spaces
 	} else {
-		// e.textArea.InsertText("\t") // Method not available
+		// e.activeTextArea().InsertText("\t") // Method not available
 	}
 	e.markDirty()
 }
 
 // File operations
 func (e *Editor) newFile() {
-	e.textArea.SetText("", true)
-	e.currentFile = ""
-	e.isDirty = false
+	leaf := e.active.Leaf
+	e.setPaneText(leaf, "")
+	leaf.File = ""
+	leaf.Dirty = false
+	leaf.History = history.NewEventHandler(0)
 	e.updateStatus()
 }
 
+// findProjectRoot walks up from dir looking for a project.ConfigFileName,
+// the same walk cmd.detectAutoCommit does, and reports the containing
+// directory if one is found.
+func findProjectRoot(dir string) (string, bool) {
+	for {
+		if info, err := os.Stat(filepath.Join(dir, project.ConfigFileName)); err == nil && !info.IsDir() {
+			return dir, true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
 func (e *Editor) openFile(filePath string) error {
 	content, err := os.ReadFile(filePath)
 	if err != nil {
 		return err
 	}
 
-	e.textArea.SetText(string(content), true)
-	e.currentFile = filePath
-	e.isDirty = false
+	leaf := e.active.Leaf
+	e.setPaneText(leaf, string(content))
+	leaf.File = filePath
+	leaf.Dirty = false
+	leaf.History = history.NewEventHandler(0)
+	if err := leaf.History.LoadSidecar(filePath); err != nil && e.config.Debug {
+		fmt.Printf("Warning: failed to load history for %s: %v\n", filePath, err)
+	}
 	e.updateStatus()
 	e.showMessage(fmt.Sprintf("Opened: %s", filepath.Base(filePath)))
+	recordRecentFile(filePath)
+	if e.plugins != nil {
+		e.plugins.RunOnOpen()
+	}
 
 	return nil
 }
 
 func (e *Editor) saveFile() {
-	if e.currentFile == "" {
+	leaf := e.active.Leaf
+	if leaf.File == "" {
 		e.showSaveAsDialog()
 		return
 	}
 
-	content := e.textArea.GetText()
-	if err := os.WriteFile(e.currentFile, []byte(content), 0644); err != nil {
+	if e.plugins != nil && !e.plugins.RunOnSave() {
+		e.showMessage("Save aborted by plugin")
+		return
+	}
+
+	content := leaf.TextArea.GetText()
+	if err := os.WriteFile(leaf.File, []byte(content), 0644); err != nil {
 		e.showError(fmt.Sprintf("Failed to save file: %v", err))
 		return
 	}
 
-	e.isDirty = false
+	leaf.Dirty = false
+	leaf.History.BreakGroup()
+	if err := leaf.History.SaveSidecar(leaf.File); err != nil && e.config.Debug {
+		fmt.Printf("Warning: failed to save history for %s: %v\n", leaf.File, err)
+	}
+
+	if e.config.AutoCommit {
+		message := fmt.Sprintf("Update %s", filepath.Base(leaf.File))
+		if err := vcs.Snapshot(filepath.Dir(leaf.File), gitAuthor(), message); err != nil && e.config.Debug {
+			fmt.Printf("Warning: failed to auto-commit %s: %v\n", leaf.File, err)
+		}
+	}
+
 	e.updateStatus()
-	e.showMessage(fmt.Sprintf("Saved: %s", filepath.Base(e.currentFile)))
+	e.showMessage(fmt.Sprintf("Saved: %s", filepath.Base(leaf.File)))
+}
+
+// gitAuthor builds the vcs.Author auto-commits are attributed to.
+func gitAuthor() vcs.Author {
+	name := os.Getenv("GIT_AUTHOR_NAME")
+	if name == "" {
+		name = os.Getenv("USER")
+	}
+	if name == "" {
+		name = "Author"
+	}
+
+	email := os.Getenv("GIT_AUTHOR_EMAIL")
+	if email == "" {
+		email = "author@localhost"
+	}
+
+	return vcs.Author{Name: name, Email: email}
+}
+
+// setPaneText rewrites leaf's text area without the change being recorded
+// into leaf's own undo history, since the editor - not the user - caused it.
+func (e *Editor) setPaneText(leaf *panes.Leaf, text string) {
+	e.suppressChangeTracking = true
+	leaf.TextArea.SetText(text, true)
+	leaf.LastText = text
+	e.suppressChangeTracking = false
 }
 
 // Theme operations
@@ -492,7 +886,7 @@ func (e *Editor) toggleTypewriterMode() {
 
 func (e *Editor) toggleDistractionFree() {
 	e.config.DistractionFree = !e.config.DistractionFree
-	e.setupUI() // Rebuild UI
+	e.rebuildLayout()
 	status := "enabled"
 	if !e.config.DistractionFree {
 		status = "disabled"
@@ -500,29 +894,179 @@ func (e *Editor) toggleDistractionFree() {
 	e.showMessage(fmt.Sprintf("Distraction-free mode %s", status))
 }
 
+// Pane operations
+func (e *Editor) splitPane(orientation panes.Orientation) {
+	e.active = e.active.Split(orientation, e.newTextArea())
+	e.wireChangeTracking(e.active.Leaf)
+	e.rebuildLayout()
+	e.showMessage("Pane split")
+}
+
+func (e *Editor) splitPaneHorizontal() { e.splitPane(panes.Horizontal) }
+func (e *Editor) splitPaneVertical()   { e.splitPane(panes.Vertical) }
+
+func (e *Editor) closePane() {
+	next, ok := e.active.Close()
+	if !ok {
+		e.showMessage("Can't close the last pane")
+		return
+	}
+	e.active = next
+	e.rebuildLayout()
+	e.showMessage("Pane closed")
+}
+
+func (e *Editor) focusNextPane() {
+	e.active = panes.Next(e.root, e.active)
+	e.rebuildLayout()
+}
+
+func (e *Editor) focusPrevPane() {
+	e.active = panes.Prev(e.root, e.active)
+	e.rebuildLayout()
+}
+
+// resizeActivePane grows (direction > 0) or shrinks (direction < 0) the
+// active pane's share of its nearest ancestor split along orientation.
+func (e *Editor) resizeActivePane(orientation panes.Orientation, direction float64) {
+	if !e.active.ResizeAlong(orientation, direction*panes.DefaultResizeStep) {
+		e.showMessage("No split to resize in that direction")
+		return
+	}
+	e.rebuildLayout()
+}
+
+// Host implementation, exposing editor state to Lua plugins via the
+// writers module (see internal/editor/plugins).
+
+// GetText returns the active pane's full text.
+func (e *Editor) GetText() string {
+	return e.activeTextArea().GetText()
+}
+
+// SetText replaces the active pane's full text and marks it dirty.
+func (e *Editor) SetText(text string) {
+	e.setPaneText(e.active.Leaf, text)
+	e.markDirty()
+}
+
+// InsertAtCursor inserts text at the active pane's cursor position.
+func (e *Editor) InsertAtCursor(text string) {
+	leaf := e.active.Leaf
+	current := leaf.TextArea.GetText()
+	row, col, _, _ := leaf.TextArea.GetCursor()
+	offset := offsetForCursor(current, row, col)
+
+	updated := current[:offset] + text + current[offset:]
+	e.setPaneText(leaf, updated)
+	leaf.TextArea.Select(offset+len(text), offset+len(text))
+	e.markDirty()
+}
+
+// GetCursor returns the active pane's cursor row and column.
+func (e *Editor) GetCursor() (row, col int) {
+	row, col, _, _ = e.activeTextArea().GetCursor()
+	return row, col
+}
+
+// SetCursor moves the active pane's cursor to row, col.
+func (e *Editor) SetCursor(row, col int) {
+	offset := offsetForCursor(e.activeTextArea().GetText(), row, col)
+	e.activeTextArea().Select(offset, offset)
+}
+
+// ShowMessage displays message in the info bar.
+func (e *Editor) ShowMessage(message string) {
+	e.showMessage(message)
+}
+
+// ShowError displays message in the info bar as an error.
+func (e *Editor) ShowError(message string) {
+	e.showError(message)
+}
+
+// GetConfig returns the string form of a named editor config value, or ""
+// if key isn't recognized.
+func (e *Editor) GetConfig(key string) string {
+	switch key {
+	case "theme":
+		return e.config.Theme
+	case "syntaxMode":
+		return e.config.SyntaxMode
+	case "tabSize":
+		return fmt.Sprintf("%d", e.config.TabSize)
+	case "typewriterMode":
+		return fmt.Sprintf("%t", e.config.TypewriterMode)
+	case "distractionFree":
+		return fmt.Sprintf("%t", e.config.DistractionFree)
+	case "autoSave":
+		return fmt.Sprintf("%t", e.config.AutoSave)
+	case "wordsPerMinute":
+		return fmt.Sprintf("%d", e.config.WordsPerMinute)
+	case "readOnly":
+		return fmt.Sprintf("%t", e.config.ReadOnly)
+	default:
+		return ""
+	}
+}
+
+// CurrentFile returns the active pane's file path, or "" if unsaved.
+func (e *Editor) CurrentFile() string {
+	return e.active.Leaf.File
+}
+
+// WordCount returns the active pane's word count.
+func (e *Editor) WordCount() int {
+	return len(strings.Fields(e.activeTextArea().GetText()))
+}
+
 // Edit operations
 func (e *Editor) undo() {
-	// TODO: Implement undo
+	leaf := e.active.Leaf
+	result, cursor, ok := leaf.History.Undo(leaf.TextArea.GetText())
+	if !ok {
+		e.showMessage("Nothing to undo")
+		return
+	}
+
+	e.applyHistoryResult(leaf, result, cursor)
 	e.showMessage("Undo")
 }
 
 func (e *Editor) redo() {
-	// TODO: Implement redo
+	leaf := e.active.Leaf
+	result, cursor, ok := leaf.History.Redo(leaf.TextArea.GetText())
+	if !ok {
+		e.showMessage("Nothing to redo")
+		return
+	}
+
+	e.applyHistoryResult(leaf, result, cursor)
 	e.showMessage("Redo")
 }
 
+// applyHistoryResult writes an undo/redo result back into leaf's text area
+// without feeding it back into the history it came from, then restores the
+// cursor and dirty state.
+func (e *Editor) applyHistoryResult(leaf *panes.Leaf, text string, cursor int) {
+	e.setPaneText(leaf, text)
+	leaf.TextArea.Select(cursor, cursor)
+	leaf.Dirty = true
+	e.updateStatus()
+}
+
 func (e *Editor) selectAll() {
-	// e.textArea.SelectAll() // Method not available
+	// e.activeTextArea().SelectAll() // Method not available
 	e.showMessage("Selected all text")
 }
 
 func (e *Editor) copy() {
-	// text := e.textArea.GetSelectedText() // Method not available, use unexported getSelectedText
+	// text := e.activeTextArea().GetSelectedText() // Method not available, use unexported getSelectedText
 	text := ""
 	if text == "" {
 		// Copy current line if no selection
-		row, _, _, _ := e.textArea.GetCursor()
-		allText := e.textArea.GetText()
+		row, _, _, _ := e.activeTextArea().GetCursor()
+		allText := e.activeTextArea().GetText()
 		lines := strings.Split(allText, "\n")
 		if row < len(lines) {
 			text = lines[row]
@@ -536,9 +1080,10 @@ func (e *Editor) copy() {
 }
 
 func (e *Editor) paste() {
+	e.breakHistoryGroup()
 	data := clipboard.Read(clipboard.FmtText)
 	if len(data) > 0 {
-		// e.textArea.InsertText(string(data)) // Method not available
+		// e.activeTextArea().InsertText(string(data)) // Method not available
 		_ = string(data)
 		e.markDirty()
 		e.showMessage("Pasted from clipboard")
@@ -547,7 +1092,7 @@ func (e *Editor) paste() {
 
 func (e *Editor) cut() {
 	e.copy()
-	// e.textArea.DeleteSelection() // Method not available
+	// e.activeTextArea().DeleteSelection() // Method not available
 	e.markDirty()
 	e.showMessage("Cut to clipboard")
 }
@@ -579,7 +1124,7 @@ func (e *Editor) showGoToLineDialog() {
 }
 
 func (e *Editor) showWordCountDialog() {
-	text := e.textArea.GetText()
+	text := e.activeTextArea().GetText()
 	words := len(strings.Fields(text))
 	chars := len(text)
 	lines := len(strings.Split(text, "\n"))
@@ -590,28 +1135,36 @@ func (e *Editor) showWordCountDialog() {
 
 // Status and messaging
 func (e *Editor) updateStatus() {
+	leaf := e.active.Leaf
+
 	fileName := "Untitled"
-	if e.currentFile != "" {
-		fileName = filepath.Base(e.currentFile)
+	if leaf.File != "" {
+		fileName = filepath.Base(leaf.File)
 	}
 
 	dirtyFlag := ""
-	if e.isDirty {
-		dirtyFlag = " ‚óè"
+	if leaf.Dirty {
+		dirtyFlag = " ●"
 	}
 
-	row, col, _, _ := e.textArea.GetCursor()
-	text := e.textArea.GetText()
+	row, col, _, _ := leaf.TextArea.GetCursor()
+	text := leaf.TextArea.GetText()
 	wordCount := len(strings.Fields(text))
 
-	status := fmt.Sprintf(" %s%s | Mode: %s | Line: %d, Col: %d | Words: %d",
-		fileName, dirtyFlag, e.mode, row+1, col+1, wordCount)
+	paneInfo := ""
+	if leaves := e.root.Leaves(); len(leaves) > 1 {
+		paneInfo = fmt.Sprintf(" | Pane %d/%d", e.activePaneIndex()+1, len(leaves))
+	}
+
+	status := fmt.Sprintf(" %s%s | Mode: %s | Line: %d, Col: %d | Words: %d%s",
+		fileName, dirtyFlag, e.mode, row+1, col+1, wordCount, paneInfo)
 
 	if e.config.TypewriterMode {
 		status += " | TYPEWRITER"
 	}
 
 	e.statusBar.SetText(status)
+	e.applyThemeToTextArea()
 }
 
 func (e *Editor) showMessage(message string) {
@@ -641,11 +1194,39 @@ func (e *Editor) showError(message string) {
 }
 
 func (e *Editor) markDirty() {
-	if !e.isDirty {
-		e.isDirty = true
+	e.markLeafDirty(e.active.Leaf)
+}
+
+// markLeafDirty flags leaf as having unsaved changes, the same as markDirty
+// but for a specific pane rather than always the active one - needed since a
+// text-change callback fires for whichever pane changed, not necessarily
+// the focused one. It also fires onEdit and, when the word count actually
+// moved, onWordCountChange.
+func (e *Editor) markLeafDirty(leaf *panes.Leaf) {
+	if e.plugins != nil {
+		e.plugins.RunOnEdit()
+	}
+
+	if !leaf.Dirty {
+		leaf.Dirty = true
 		e.updateStatus()
 		e.resetAutoSave()
 	}
+
+	e.checkWordCountChange(leaf)
+}
+
+// checkWordCountChange fires onWordCountChange when leaf's word count
+// differs from what it was the last time this ran.
+func (e *Editor) checkWordCountChange(leaf *panes.Leaf) {
+	count := len(strings.Fields(leaf.TextArea.GetText()))
+	if count == leaf.LastWordCount {
+		return
+	}
+	leaf.LastWordCount = count
+	if e.plugins != nil {
+		e.plugins.RunOnWordCountChange(count)
+	}
 }
 
 func (e *Editor) render() {
@@ -659,13 +1240,31 @@ func (e *Editor) startAutoSave() {
 	}
 
 	e.autoSaveTimer = time.AfterFunc(e.config.AutoSaveInterval, func() {
-		if e.isDirty && e.currentFile != "" {
-			e.saveFile()
-		}
+		e.autoSaveDirtyPanes()
 		e.startAutoSave() // Restart timer
 	})
 }
 
+// autoSaveDirtyPanes saves every pane with unsaved changes and a known file
+// path, leaving which pane is focused untouched.
+func (e *Editor) autoSaveDirtyPanes() {
+	saved := false
+	for _, n := range e.root.Leaves() {
+		leaf := n.Leaf
+		if !leaf.Dirty || leaf.File == "" {
+			continue
+		}
+		if err := os.WriteFile(leaf.File, []byte(leaf.TextArea.GetText()), 0644); err != nil {
+			continue
+		}
+		leaf.Dirty = false
+		saved = true
+	}
+	if saved {
+		e.updateStatus()
+	}
+}
+
 func (e *Editor) resetAutoSave() {
 	if e.config.AutoSave {
 		e.startAutoSave()
@@ -673,15 +1272,34 @@ func (e *Editor) resetAutoSave() {
 }
 
 func (e *Editor) exit() {
-	if e.isDirty {
-		// TODO: Show confirmation dialog
-		e.showMessage("File has unsaved changes. Press Ctrl+X again to exit anyway.")
+	for _, n := range e.root.Leaves() {
+		if n.Leaf.Dirty {
+			// TODO: Show confirmation dialog
+			e.showMessage("File has unsaved changes. Press Ctrl+X again to exit anyway.")
+			return
+		}
+	}
+
+	if e.plugins != nil && !e.plugins.RunOnBeforeExit() {
+		e.showMessage("Exit aborted by plugin")
 		return
 	}
 
 	if e.autoSaveTimer != nil {
 		e.autoSaveTimer.Stop()
 	}
+	if e.plugins != nil {
+		e.plugins.Close()
+	}
+	if e.themeWatchStop != nil {
+		e.themeWatchStop()
+	}
+
+	if statePath, err := themes.DefaultStatePath(); err == nil {
+		if err := e.themeManager.SaveState(statePath); err != nil && e.config.Debug {
+			fmt.Printf("Warning: failed to save theme state: %v\n", err)
+		}
+	}
 
 	e.app.Stop()
 }
@@ -0,0 +1,270 @@
+// Package history implements undo/redo for a single pane: a stack of
+// TextEvents that know how to apply and revert themselves against a text
+// buffer, with consecutive single-character edits grouped into one undoable
+// step and a sidecar file so a pane's history survives reopening its file.
+package history
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+	"unicode/utf8"
+)
+
+// Op identifies whether a TextEvent inserted or deleted text.
+type Op string
+
+const (
+	Insert Op = "insert"
+	Delete Op = "delete"
+)
+
+// TextEvent is one undoable edit: Op Insert means Text was inserted at Pos;
+// Op Delete means Text was removed starting at Pos. Pos and the offsets
+// within Text are byte offsets into the buffer, matching tview.TextArea's
+// own Select(start, end) convention.
+type TextEvent struct {
+	Op           Op        `json:"op"`
+	Pos          int       `json:"pos"`
+	Text         string    `json:"text"`
+	Time         time.Time `json:"time"`
+	CursorBefore int       `json:"cursor_before"`
+	CursorAfter  int       `json:"cursor_after"`
+}
+
+// Apply replays e forward against text.
+func (e TextEvent) Apply(text string) string {
+	switch e.Op {
+	case Insert:
+		return text[:e.Pos] + e.Text + text[e.Pos:]
+	case Delete:
+		return text[:e.Pos] + text[e.Pos+len(e.Text):]
+	default:
+		return text
+	}
+}
+
+// Revert undoes e against text.
+func (e TextEvent) Revert(text string) string {
+	switch e.Op {
+	case Insert:
+		return text[:e.Pos] + text[e.Pos+len(e.Text):]
+	case Delete:
+		return text[:e.Pos] + e.Text + text[e.Pos:]
+	default:
+		return text
+	}
+}
+
+// GroupWindow is how soon after the previous edit a new single-character
+// insertion or deletion must land to be folded into the same undo step.
+const GroupWindow = 400 * time.Millisecond
+
+// DefaultMaxDepth caps how many undo steps accumulate before the oldest are
+// dropped, when EventHandler is given a maxDepth of 0.
+const DefaultMaxDepth = 1000
+
+// EventHandler records TextEvents onto undo/redo stacks for a single pane,
+// grouping consecutive single-character edits so a typed or backspaced word
+// undoes as a unit rather than one keystroke at a time.
+type EventHandler struct {
+	undo []TextEvent
+	redo []TextEvent
+
+	maxDepth    int
+	groupBroken bool
+}
+
+// NewEventHandler creates an EventHandler capped at maxDepth events (0 uses
+// DefaultMaxDepth).
+func NewEventHandler(maxDepth int) *EventHandler {
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxDepth
+	}
+	return &EventHandler{maxDepth: maxDepth}
+}
+
+// BreakGroup forces the next recorded event to start a new undo step rather
+// than merge into the previous one. Callers use this on a newline, mode
+// switch, paste, cursor jump, or save.
+func (h *EventHandler) BreakGroup() {
+	h.groupBroken = true
+}
+
+// Record adds ev to the undo stack, merging it into the previous event when
+// both are single-character edits of the same kind, contiguous in position,
+// and within GroupWindow of each other. Recording anything clears the redo
+// stack, since it invalidates any previously undone future.
+func (h *EventHandler) Record(ev TextEvent) {
+	h.redo = nil
+
+	if !h.groupBroken && len(h.undo) > 0 {
+		last := &h.undo[len(h.undo)-1]
+		if mergeable(*last, ev) {
+			merge(last, ev)
+			return
+		}
+	}
+
+	h.undo = append(h.undo, ev)
+	if len(h.undo) > h.maxDepth {
+		h.undo = h.undo[len(h.undo)-h.maxDepth:]
+	}
+	h.groupBroken = false
+}
+
+// mergeable reports whether next should fold into prev instead of becoming
+// its own undo step.
+func mergeable(prev, next TextEvent) bool {
+	if prev.Op != next.Op {
+		return false
+	}
+	if next.Time.Sub(prev.Time) > GroupWindow {
+		return false
+	}
+	if utf8.RuneCountInString(next.Text) != 1 {
+		return false
+	}
+
+	switch prev.Op {
+	case Insert:
+		return next.Pos == prev.Pos+len(prev.Text)
+	case Delete:
+		return next.Pos == prev.Pos || next.Pos+len(next.Text) == prev.Pos
+	default:
+		return false
+	}
+}
+
+// merge folds next into prev, which mergeable has already approved.
+func merge(prev *TextEvent, next TextEvent) {
+	switch prev.Op {
+	case Insert:
+		prev.Text += next.Text
+	case Delete:
+		if next.Pos == prev.Pos {
+			prev.Text += next.Text
+		} else {
+			prev.Text = next.Text + prev.Text
+			prev.Pos = next.Pos
+		}
+	}
+	prev.CursorAfter = next.CursorAfter
+	prev.Time = next.Time
+}
+
+// CanUndo reports whether there is an event to undo.
+func (h *EventHandler) CanUndo() bool { return len(h.undo) > 0 }
+
+// CanRedo reports whether there is an event to redo.
+func (h *EventHandler) CanRedo() bool { return len(h.redo) > 0 }
+
+// Undo reverts the most recent event against text and moves it onto the
+// redo stack. ok is false if there was nothing to undo, in which case text
+// is returned unchanged.
+func (h *EventHandler) Undo(text string) (result string, cursor int, ok bool) {
+	if len(h.undo) == 0 {
+		return text, 0, false
+	}
+
+	ev := h.undo[len(h.undo)-1]
+	h.undo = h.undo[:len(h.undo)-1]
+	h.redo = append(h.redo, ev)
+	h.groupBroken = true
+
+	return ev.Revert(text), ev.CursorBefore, true
+}
+
+// Redo re-applies the most recently undone event and moves it back onto the
+// undo stack. ok is false if there was nothing to redo, in which case text
+// is returned unchanged.
+func (h *EventHandler) Redo(text string) (result string, cursor int, ok bool) {
+	if len(h.redo) == 0 {
+		return text, 0, false
+	}
+
+	ev := h.redo[len(h.redo)-1]
+	h.redo = h.redo[:len(h.redo)-1]
+	h.undo = append(h.undo, ev)
+	h.groupBroken = true
+
+	return ev.Apply(text), ev.CursorAfter, true
+}
+
+// SaveSidecar persists h's undo stack to filePath's history sidecar, so a
+// later LoadSidecar for the same path can restore it. A blank filePath is a
+// no-op, since an unsaved buffer has nowhere stable to key its sidecar on.
+func (h *EventHandler) SaveSidecar(filePath string) error {
+	if filePath == "" {
+		return nil
+	}
+
+	path, err := sidecarPath(filePath)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(h.undo)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// LoadSidecar restores h's undo stack from filePath's history sidecar, if
+// one exists. A missing sidecar isn't an error - it just means no history
+// has been recorded for this file yet.
+func (h *EventHandler) LoadSidecar(filePath string) error {
+	if filePath == "" {
+		return nil
+	}
+
+	path, err := sidecarPath(filePath)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var events []TextEvent
+	if err := json.Unmarshal(data, &events); err != nil {
+		return err
+	}
+
+	h.undo = events
+	h.redo = nil
+	h.groupBroken = true
+	return nil
+}
+
+// sidecarPath returns the history sidecar for filePath, keyed by a hash of
+// its absolute path so sidecars can live in a flat directory.
+func sidecarPath(filePath string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".writers-cli", "history", filehash(filePath)), nil
+}
+
+func filehash(filePath string) string {
+	abs, err := filepath.Abs(filePath)
+	if err != nil {
+		abs = filePath
+	}
+	sum := sha256.Sum256([]byte(abs))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,191 @@
+package history
+
+import (
+	"testing"
+	"time"
+)
+
+func insertAt(pos int, text string, t time.Time) TextEvent {
+	return TextEvent{Op: Insert, Pos: pos, Text: text, Time: t}
+}
+
+func deleteAt(pos int, text string, t time.Time) TextEvent {
+	return TextEvent{Op: Delete, Pos: pos, Text: text, Time: t}
+}
+
+func TestTextEventApplyRevertInsert(t *testing.T) {
+	ev := insertAt(3, "lo wo", time.Now())
+	got := ev.Apply("helrld")
+	want := "hello world"
+	if got != want {
+		t.Fatalf("Apply() = %q, want %q", got, want)
+	}
+	if back := ev.Revert(got); back != "helrld" {
+		t.Fatalf("Revert() = %q, want %q", back, "helrld")
+	}
+}
+
+func TestTextEventApplyRevertDelete(t *testing.T) {
+	ev := deleteAt(5, " world", time.Now())
+	got := ev.Apply("hello world")
+	want := "hello"
+	if got != want {
+		t.Fatalf("Apply() = %q, want %q", got, want)
+	}
+	if back := ev.Revert(got); back != "hello world" {
+		t.Fatalf("Revert() = %q, want %q", back, "hello world")
+	}
+}
+
+func TestRecordMergesConsecutiveInserts(t *testing.T) {
+	h := NewEventHandler(0)
+	now := time.Now()
+
+	h.Record(insertAt(0, "h", now))
+	h.Record(insertAt(1, "i", now.Add(10*time.Millisecond)))
+
+	if len(h.undo) != 1 {
+		t.Fatalf("expected merged undo entries, got %d", len(h.undo))
+	}
+	if h.undo[0].Text != "hi" {
+		t.Fatalf("merged text = %q, want %q", h.undo[0].Text, "hi")
+	}
+}
+
+func TestRecordDoesNotMergeAcrossGroupWindow(t *testing.T) {
+	h := NewEventHandler(0)
+	now := time.Now()
+
+	h.Record(insertAt(0, "h", now))
+	h.Record(insertAt(1, "i", now.Add(GroupWindow+time.Millisecond)))
+
+	if len(h.undo) != 2 {
+		t.Fatalf("expected separate undo entries, got %d", len(h.undo))
+	}
+}
+
+func TestRecordBreakGroupForcesNewStep(t *testing.T) {
+	h := NewEventHandler(0)
+	now := time.Now()
+
+	h.Record(insertAt(0, "h", now))
+	h.BreakGroup()
+	h.Record(insertAt(1, "i", now.Add(10*time.Millisecond)))
+
+	if len(h.undo) != 2 {
+		t.Fatalf("expected BreakGroup to prevent merge, got %d undo entries", len(h.undo))
+	}
+}
+
+func TestRecordClearsRedoStack(t *testing.T) {
+	h := NewEventHandler(0)
+	now := time.Now()
+
+	h.Record(insertAt(0, "a", now))
+	text, _, ok := h.Undo("a")
+	if !ok || text != "" {
+		t.Fatalf("Undo() = %q, %v, want \"\", true", text, ok)
+	}
+	if !h.CanRedo() {
+		t.Fatal("expected CanRedo() after Undo()")
+	}
+
+	h.Record(insertAt(0, "b", now.Add(time.Second)))
+	if h.CanRedo() {
+		t.Fatal("expected Record() to clear the redo stack")
+	}
+}
+
+func TestUndoRedoRoundTrip(t *testing.T) {
+	h := NewEventHandler(0)
+	now := time.Now()
+	h.BreakGroup()
+	h.Record(insertAt(0, "hello", now))
+
+	if h.CanUndo() == false {
+		t.Fatal("expected CanUndo() after Record()")
+	}
+
+	undone, _, ok := h.Undo("hello")
+	if !ok || undone != "" {
+		t.Fatalf("Undo() = %q, %v, want \"\", true", undone, ok)
+	}
+
+	redone, _, ok := h.Redo(undone)
+	if !ok || redone != "hello" {
+		t.Fatalf("Redo() = %q, %v, want \"hello\", true", redone, ok)
+	}
+}
+
+func TestUndoRedoEmptyStacks(t *testing.T) {
+	h := NewEventHandler(0)
+
+	if _, _, ok := h.Undo("text"); ok {
+		t.Fatal("Undo() on empty stack should report ok=false")
+	}
+	if _, _, ok := h.Redo("text"); ok {
+		t.Fatal("Redo() on empty stack should report ok=false")
+	}
+}
+
+func TestNewEventHandlerMaxDepth(t *testing.T) {
+	h := NewEventHandler(2)
+	now := time.Now()
+
+	h.BreakGroup()
+	h.Record(insertAt(0, "a", now))
+	h.BreakGroup()
+	h.Record(insertAt(1, "b", now.Add(time.Second)))
+	h.BreakGroup()
+	h.Record(insertAt(2, "c", now.Add(2*time.Second)))
+
+	if len(h.undo) != 2 {
+		t.Fatalf("expected undo stack capped at maxDepth=2, got %d", len(h.undo))
+	}
+	if h.undo[0].Text != "b" || h.undo[1].Text != "c" {
+		t.Fatalf("expected oldest entry to be dropped, got %+v", h.undo)
+	}
+}
+
+func TestSidecarRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+
+	h := NewEventHandler(0)
+	now := time.Now()
+	h.BreakGroup()
+	h.Record(insertAt(0, "draft text", now))
+
+	filePath := dir + "/novel.md"
+	if err := h.SaveSidecar(filePath); err != nil {
+		t.Fatalf("SaveSidecar() error = %v", err)
+	}
+
+	restored := NewEventHandler(0)
+	if err := restored.LoadSidecar(filePath); err != nil {
+		t.Fatalf("LoadSidecar() error = %v", err)
+	}
+	if len(restored.undo) != 1 || restored.undo[0].Text != "draft text" {
+		t.Fatalf("LoadSidecar() restored %+v, want one event with text %q", restored.undo, "draft text")
+	}
+}
+
+func TestLoadSidecarMissingFileIsNotError(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+
+	h := NewEventHandler(0)
+	if err := h.LoadSidecar(dir + "/never-saved.md"); err != nil {
+		t.Fatalf("LoadSidecar() on missing sidecar should not error, got %v", err)
+	}
+	if h.CanUndo() {
+		t.Fatal("expected no undo history after loading a missing sidecar")
+	}
+}
+
+func TestSaveSidecarBlankPathIsNoop(t *testing.T) {
+	h := NewEventHandler(0)
+	if err := h.SaveSidecar(""); err != nil {
+		t.Fatalf("SaveSidecar(\"\") error = %v", err)
+	}
+}
@@ -0,0 +1,418 @@
+package editor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/yosuke-furukawa/json5/encoding/json5"
+
+	"writers-cli/internal/editor/panes"
+)
+
+// Key is a human-readable key chord descriptor, e.g. "ctrl+s" or, for a
+// chorded sequence, "ctrl+k ctrl+s". It is both the format used in
+// bindings.json5 and the map key for in-memory binding tables.
+type Key string
+
+// KeyPress is a single parsed key event, as reported by tcell: either a
+// named/control key (Key set) or a printable rune (Rune set), plus whatever
+// modifiers accompany it.
+type KeyPress struct {
+	Key  tcell.Key
+	Rune rune
+	Mods tcell.ModMask
+}
+
+// chordBinding is a compiled entry from a bindings map: the sequence of key
+// presses that trigger it, and the ordered action names to run.
+type chordBinding struct {
+	descriptor Key
+	keys       []KeyPress
+	actions    []string
+}
+
+// chordMatch describes how a pending sequence of key presses relates to the
+// compiled bindings.
+type chordMatch int
+
+const (
+	chordNone chordMatch = iota
+	chordPrefix
+	chordMatched
+)
+
+// bindingActions exposes every rebindable editor operation as a named
+// action. Third-party features add to this table to become bindable from
+// bindings.json5 without touching setupKeybindings. It's a function rather
+// than a package var because the CommandPalette entry's dependency on
+// paletteCandidates (which itself reads this table) would otherwise form an
+// initialization cycle.
+func bindingActions() map[string]func(*Editor) bool {
+	return map[string]func(*Editor) bool{
+		"Help":              func(e *Editor) bool { e.showHelp(); return true },
+		"SwitchTheme":       func(e *Editor) bool { e.switchTheme(); return true },
+		"ToggleTypewriter":  func(e *Editor) bool { e.toggleTypewriterMode(); return true },
+		"ToggleDistraction": func(e *Editor) bool { e.toggleDistractionFree(); return true },
+		"Save":              func(e *Editor) bool { e.saveFile(); return true },
+		"Open":              func(e *Editor) bool { e.showOpenDialog(); return true },
+		"SaveAs":            func(e *Editor) bool { e.showSaveAsDialog(); return true },
+		"Exit":              func(e *Editor) bool { e.exit(); return true },
+		"FindNext":          func(e *Editor) bool { e.showFindDialog(); return true },
+		"GoToLine":          func(e *Editor) bool { e.showGoToLineDialog(); return true },
+		"WordCount":         func(e *Editor) bool { e.showWordCountDialog(); return true },
+		"FileHistory":       func(e *Editor) bool { e.showFileHistory(); return true },
+		"Undo":              func(e *Editor) bool { e.undo(); return true },
+		"Redo":              func(e *Editor) bool { e.redo(); return true },
+		"SelectAll":         func(e *Editor) bool { e.selectAll(); return true },
+		"Copy":              func(e *Editor) bool { e.copy(); return true },
+		"Paste":             func(e *Editor) bool { e.paste(); return true },
+		"Cut":               func(e *Editor) bool { e.cut(); return true },
+		"InsertMode":        func(e *Editor) bool { e.setMode(ModeInsert); return true },
+		"NavigationMode":    func(e *Editor) bool { e.setMode(ModeNavigation); return true },
+		"InsertTab":         func(e *Editor) bool { e.insertTab(); return true },
+		"CursorLeft":        func(e *Editor) bool { e.moveCursor(-1, 0); return true },
+		"CursorRight":       func(e *Editor) bool { e.moveCursor(1, 0); return true },
+		"CursorUp":          func(e *Editor) bool { e.moveCursor(0, -1); return true },
+		"CursorDown":        func(e *Editor) bool { e.moveCursor(0, 1); return true },
+		"LineStart":         func(e *Editor) bool { e.moveToLineStart(); return true },
+		"LineEnd":           func(e *Editor) bool { e.moveToLineEnd(); return true },
+		"PageUp":            func(e *Editor) bool { e.pageUp(); return true },
+		"PageDown":          func(e *Editor) bool { e.pageDown(); return true },
+		"CommandPalette":    func(e *Editor) bool { e.showCommandPalette(); return true },
+
+		"SplitHorizontal":        func(e *Editor) bool { e.splitPaneHorizontal(); return true },
+		"SplitVertical":          func(e *Editor) bool { e.splitPaneVertical(); return true },
+		"ClosePane":              func(e *Editor) bool { e.closePane(); return true },
+		"FocusNext":              func(e *Editor) bool { e.focusNextPane(); return true },
+		"FocusPrev":              func(e *Editor) bool { e.focusPrevPane(); return true },
+		"ResizePaneGrowWidth":    func(e *Editor) bool { e.resizeActivePane(panes.Horizontal, 1); return true },
+		"ResizePaneShrinkWidth":  func(e *Editor) bool { e.resizeActivePane(panes.Horizontal, -1); return true },
+		"ResizePaneGrowHeight":   func(e *Editor) bool { e.resizeActivePane(panes.Vertical, 1); return true },
+		"ResizePaneShrinkHeight": func(e *Editor) bool { e.resizeActivePane(panes.Vertical, -1); return true },
+	}
+}
+
+// defaultBindings reproduces the shortcuts that used to be hard-coded in
+// setupKeybindings. It is used whenever bindings.json5 is absent, invalid,
+// or missing an entry.
+func defaultBindings() map[Key][]string {
+	return map[Key][]string{
+		"f1":            {"Help"},
+		"f2":            {"SwitchTheme"},
+		"f9":            {"ToggleTypewriter"},
+		"f11":           {"ToggleDistraction"},
+		"ctrl+s":        {"Save"},
+		"ctrl+o":        {"Open"},
+		"ctrl+x":        {"Exit"},
+		"ctrl+f":        {"FindNext"},
+		"ctrl+g":        {"GoToLine"},
+		"ctrl+w":        {"WordCount"},
+		"ctrl+k ctrl+h": {"FileHistory"},
+		"ctrl+z":        {"Undo"},
+		"ctrl+y":        {"Redo"},
+		"ctrl+a":        {"SelectAll"},
+		"ctrl+c":        {"Copy"},
+		"ctrl+v":        {"Paste"},
+		"ctrl+shift+p":  {"CommandPalette"},
+		"f3":            {"SplitHorizontal"},
+		"f4":            {"SplitVertical"},
+		"f5":            {"ClosePane"},
+		"f6":            {"FocusNext"},
+		"shift+f6":      {"FocusPrev"},
+		"alt+right":     {"ResizePaneGrowWidth"},
+		"alt+left":      {"ResizePaneShrinkWidth"},
+		"alt+down":      {"ResizePaneGrowHeight"},
+		"alt+up":        {"ResizePaneShrinkHeight"},
+	}
+}
+
+// namedKeys maps lowercase key names to dedicated tcell.Key constants.
+var namedKeys = map[string]tcell.Key{
+	"enter":     tcell.KeyEnter,
+	"return":    tcell.KeyEnter,
+	"esc":       tcell.KeyEscape,
+	"escape":    tcell.KeyEscape,
+	"tab":       tcell.KeyTab,
+	"backtab":   tcell.KeyBacktab,
+	"backspace": tcell.KeyBackspace2,
+	"delete":    tcell.KeyDelete,
+	"del":       tcell.KeyDelete,
+	"insert":    tcell.KeyInsert,
+	"home":      tcell.KeyHome,
+	"end":       tcell.KeyEnd,
+	"pgup":      tcell.KeyPgUp,
+	"pageup":    tcell.KeyPgUp,
+	"pgdn":      tcell.KeyPgDn,
+	"pagedown":  tcell.KeyPgDn,
+	"up":        tcell.KeyUp,
+	"down":      tcell.KeyDown,
+	"left":      tcell.KeyLeft,
+	"right":     tcell.KeyRight,
+}
+
+// namedRuneAliases maps key names that stand in for a single printable rune.
+var namedRuneAliases = map[string]rune{
+	"space": ' ',
+	"plus":  '+',
+	"minus": '-',
+}
+
+// ParseKey parses a single key token, e.g. "CtrlS", "Alt-f", "F2", or
+// "Shift-Tab", into the tcell key/rune pair it represents.
+func ParseKey(token string) (KeyPress, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return KeyPress{}, fmt.Errorf("empty key token")
+	}
+
+	mods, base := splitModifiers(token)
+	if base == "" {
+		return KeyPress{}, fmt.Errorf("%q has modifiers but no key", token)
+	}
+
+	lower := strings.ToLower(base)
+
+	// tcell reports Shift+Tab as its own key rather than Tab+ModShift.
+	if lower == "tab" && mods&tcell.ModShift != 0 {
+		return KeyPress{Key: tcell.KeyBacktab, Mods: mods &^ tcell.ModShift}, nil
+	}
+
+	if key, ok := namedKeys[lower]; ok {
+		return KeyPress{Key: key, Mods: mods}, nil
+	}
+
+	if n, ok := functionKeyNumber(lower); ok {
+		return KeyPress{Key: tcell.Key(int(tcell.KeyF1) + n - 1), Mods: mods}, nil
+	}
+
+	r, ok := namedRuneAliases[lower]
+	if !ok {
+		runes := []rune(base)
+		if len(runes) != 1 {
+			return KeyPress{}, fmt.Errorf("unrecognized key %q", token)
+		}
+		r = runes[0]
+	}
+
+	if mods&tcell.ModCtrl != 0 {
+		return ctrlKeyPress(r, mods)
+	}
+
+	return KeyPress{Key: tcell.KeyRune, Rune: r, Mods: mods}, nil
+}
+
+// ctrlKeyPress maps a Ctrl-modified rune to the matching tcell control key.
+// Ctrl-H/I/M alias Backspace/Tab/Enter, so those are handled explicitly.
+// Ctrl+letter control codes don't carry case, so terminals report "Ctrl-P"
+// and "Ctrl-Shift-P" identically; Shift is dropped here to match that.
+func ctrlKeyPress(r rune, mods tcell.ModMask) (KeyPress, error) {
+	mods &^= tcell.ModShift
+
+	switch unicode.ToLower(r) {
+	case 'h':
+		return KeyPress{Key: tcell.KeyBackspace, Mods: mods}, nil
+	case 'i':
+		return KeyPress{Key: tcell.KeyTab, Mods: mods}, nil
+	case 'm':
+		return KeyPress{Key: tcell.KeyEnter, Mods: mods}, nil
+	}
+
+	lower := unicode.ToLower(r)
+	if lower >= 'a' && lower <= 'z' {
+		return KeyPress{Key: tcell.Key(int(tcell.KeyCtrlA) + int(lower-'a')), Mods: mods}, nil
+	}
+
+	return KeyPress{}, fmt.Errorf("ctrl+%c is not supported", r)
+}
+
+// functionKeyNumber parses "f1".."f24" into its numeric suffix.
+func functionKeyNumber(lower string) (int, bool) {
+	if !strings.HasPrefix(lower, "f") || len(lower) < 2 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(lower[1:])
+	if err != nil || n < 1 || n > 24 {
+		return 0, false
+	}
+	return n, true
+}
+
+// splitModifiers strips leading Ctrl/Alt/Shift/Meta prefixes (with or
+// without a "-"/"+" separator, e.g. "CtrlS", "Ctrl-S", "Ctrl+S") off token,
+// returning the accumulated modifiers and the remaining base key name.
+func splitModifiers(token string) (tcell.ModMask, string) {
+	var mods tcell.ModMask
+	rest := token
+
+	for {
+		switch {
+		case hasFoldPrefix(rest, "ctrl"):
+			mods |= tcell.ModCtrl
+			rest = rest[len("ctrl"):]
+		case hasFoldPrefix(rest, "alt"):
+			mods |= tcell.ModAlt
+			rest = rest[len("alt"):]
+		case hasFoldPrefix(rest, "shift"):
+			mods |= tcell.ModShift
+			rest = rest[len("shift"):]
+		case hasFoldPrefix(rest, "meta"):
+			mods |= tcell.ModMeta
+			rest = rest[len("meta"):]
+		default:
+			return mods, rest
+		}
+		rest = strings.TrimLeft(rest, "-+")
+	}
+}
+
+// hasFoldPrefix reports whether s starts with prefix, case-insensitively,
+// and has at least one character left over (so "Ctrl" alone isn't consumed
+// as a modifier with no base key).
+func hasFoldPrefix(s, prefix string) bool {
+	return len(s) > len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+// parseChord splits a (possibly chorded) key descriptor like
+// "ctrl+k ctrl+s" into its individual key presses.
+func parseChord(descriptor Key) ([]KeyPress, error) {
+	tokens := strings.Fields(string(descriptor))
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty key descriptor")
+	}
+
+	keys := make([]KeyPress, 0, len(tokens))
+	for _, token := range tokens {
+		kp, err := ParseKey(token)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", descriptor, err)
+		}
+		keys = append(keys, kp)
+	}
+
+	return keys, nil
+}
+
+// compileBindings parses every chord in bindings and validates its actions,
+// logging (but not failing on) anything malformed.
+func (e *Editor) compileBindings(bindings map[Key][]string) []chordBinding {
+	compiled := make([]chordBinding, 0, len(bindings))
+
+	for descriptor, actions := range bindings {
+		keys, err := parseChord(descriptor)
+		if err != nil {
+			e.showError(fmt.Sprintf("keybindings: %v", err))
+			continue
+		}
+
+		for _, action := range actions {
+			if _, ok := bindingActions()[action]; ok {
+				continue
+			}
+			if e.plugins != nil && e.plugins.HasAction(action) {
+				continue
+			}
+			e.showError(fmt.Sprintf("keybindings: unknown action %q bound to %q", action, descriptor))
+		}
+
+		compiled = append(compiled, chordBinding{descriptor: descriptor, keys: keys, actions: actions})
+	}
+
+	return compiled
+}
+
+// loadKeyBindings returns defaultBindings() overlaid with any entries from
+// bindings.json5, falling back to defaults entirely when the file is
+// missing or fails to parse.
+func (e *Editor) loadKeyBindings() map[Key][]string {
+	bindings := defaultBindings()
+
+	path, err := bindingsConfigPath()
+	if err != nil {
+		return bindings
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return bindings
+	}
+
+	var overrides map[Key][]string
+	if err := json5.Unmarshal(data, &overrides); err != nil {
+		e.showError(fmt.Sprintf("keybindings: failed to parse %s: %v", path, err))
+		return bindings
+	}
+
+	for descriptor, actions := range overrides {
+		bindings[descriptor] = actions
+	}
+
+	return bindings
+}
+
+// bindingsConfigPath returns ~/.config/writers-cli/bindings.json5.
+func bindingsConfigPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "writers-cli", "bindings.json5"), nil
+}
+
+// matchChord reports how pending relates to the compiled chord bindings:
+// an exact match, a prefix of one or more longer chords, or no relation.
+func matchChord(compiled []chordBinding, pending []KeyPress) (*chordBinding, chordMatch) {
+	matchedPrefix := false
+
+	for i := range compiled {
+		b := &compiled[i]
+		if len(b.keys) < len(pending) || !keyPressesEqual(b.keys[:len(pending)], pending) {
+			continue
+		}
+		if len(b.keys) == len(pending) {
+			return b, chordMatched
+		}
+		matchedPrefix = true
+	}
+
+	if matchedPrefix {
+		return nil, chordPrefix
+	}
+	return nil, chordNone
+}
+
+func keyPressesEqual(a, b []KeyPress) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// runActions executes a chord's actions in order, logging unknown ones to
+// the info bar instead of failing the whole binding. Actions not found in
+// bindingActions fall back to plugin-registered actions.
+func (e *Editor) runActions(actions []string) {
+	for _, action := range actions {
+		if fn, ok := bindingActions()[action]; ok {
+			fn(e)
+			continue
+		}
+
+		if e.plugins != nil {
+			if found, err := e.plugins.RunAction(action); found {
+				if err != nil {
+					e.showError(fmt.Sprintf("plugin action %q failed: %v", action, err))
+				}
+				continue
+			}
+		}
+
+		e.showError(fmt.Sprintf("keybindings: unknown action %q", action))
+	}
+}
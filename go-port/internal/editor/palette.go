@@ -0,0 +1,629 @@
+package editor
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const (
+	palettePage         = "palette"
+	paletteMaxResults   = 20
+	paletteHistoryLimit = 1000
+	recentFilesLimit    = 20
+	recentFilesFileName = "recent_files"
+	paletteHistoryFile  = "palette_history"
+)
+
+// paletteCandidate is one entry offered by the command palette: a named
+// action, an installed theme, a recently opened file, or a heading in the
+// current document.
+type paletteCandidate struct {
+	label string
+	run   func(*Editor)
+}
+
+// paletteMatch pairs a candidate with its fuzzy-match score and the label
+// rendered with matched characters highlighted.
+type paletteMatch struct {
+	candidate   paletteCandidate
+	highlighted string
+	score       int
+}
+
+// showCommandPalette opens the Ctrl-Shift-P command palette: a filterable
+// list of every named action plus dynamic entries for themes, recent files,
+// and headings in the current document.
+func (e *Editor) showCommandPalette() {
+	candidates := e.paletteCandidates()
+
+	history := loadPaletteHistory()
+	historyRank := make(map[string]int, len(history))
+	rank := 0
+	for i := len(history) - 1; i >= 0; i-- {
+		if _, seen := historyRank[history[i]]; !seen {
+			historyRank[history[i]] = rank
+			rank++
+		}
+	}
+
+	theme := e.themeManager.GetCurrentTheme()
+	highlightColor := theme.GetInfoColorCode()
+
+	list := tview.NewList().ShowSecondaryText(false)
+	list.SetMainTextColor(e.color(theme.GetForegroundColor()))
+	list.SetSelectedBackgroundColor(e.color(theme.GetBorderFocusColor()))
+	list.SetSelectedTextColor(e.color(theme.GetBackgroundColor()))
+	list.SetHighlightFullLine(true)
+
+	input := tview.NewInputField().
+		SetLabel("> ").
+		SetFieldBackgroundColor(e.color(theme.GetBackgroundColor())).
+		SetFieldTextColor(e.color(theme.GetForegroundColor()))
+
+	var current []paletteMatch
+
+	runSelected := func(index int) {
+		if index < 0 || index >= len(current) {
+			return
+		}
+		match := current[index]
+		e.closePalette()
+		recordPaletteHistory(match.candidate.label)
+		match.candidate.run(e)
+	}
+
+	refresh := func(query string) {
+		current = rankPaletteCandidates(candidates, query, historyRank, highlightColor)
+		list.Clear()
+		for i, m := range current {
+			index := i
+			list.AddItem(m.highlighted, "", 0, func() { runSelected(index) })
+		}
+	}
+	refresh("")
+
+	input.SetChangedFunc(refresh)
+	input.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			e.closePalette()
+			return nil
+		case tcell.KeyUp:
+			if idx := list.GetCurrentItem() - 1; idx >= 0 {
+				list.SetCurrentItem(idx)
+			}
+			return nil
+		case tcell.KeyDown:
+			if idx := list.GetCurrentItem() + 1; idx < list.GetItemCount() {
+				list.SetCurrentItem(idx)
+			}
+			return nil
+		case tcell.KeyEnter:
+			runSelected(list.GetCurrentItem())
+			return nil
+		}
+		return event
+	})
+
+	modal := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(input, 1, 0, true).
+		AddItem(list, 0, 1, false)
+	modal.SetBorder(true).SetTitle(" Command Palette ")
+	modal.SetBackgroundColor(e.color(theme.GetBackgroundColor()))
+
+	e.pages.AddPage(palettePage, centeredModal(modal, 70, 18), true, true)
+	e.app.SetFocus(input)
+}
+
+// closePalette tears down the palette page and returns focus to the text
+// area.
+func (e *Editor) closePalette() {
+	e.pages.RemovePage(palettePage)
+	e.app.SetFocus(e.activeTextArea())
+}
+
+// centeredModal wraps p in nested flex boxes so it renders as a
+// width x height box centered on screen.
+func centeredModal(p tview.Primitive, width, height int) tview.Primitive {
+	return tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(p, height, 1, true).
+			AddItem(nil, 0, 1, false), width, 1, true).
+		AddItem(nil, 0, 1, false)
+}
+
+// paletteCandidates assembles every action, installed theme, recently
+// opened file, and heading in the current document into palette entries.
+func (e *Editor) paletteCandidates() []paletteCandidate {
+	var candidates []paletteCandidate
+
+	actionNames := make([]string, 0, len(bindingActions()))
+	for name := range bindingActions() {
+		actionNames = append(actionNames, name)
+	}
+	sort.Strings(actionNames)
+	for _, name := range actionNames {
+		action := bindingActions()[name]
+		candidates = append(candidates, paletteCandidate{
+			label: name,
+			run:   func(e *Editor) { action(e) },
+		})
+	}
+
+	if e.plugins != nil {
+		for _, name := range e.plugins.ActionNames() {
+			name := name
+			candidates = append(candidates, paletteCandidate{
+				label: name,
+				run: func(e *Editor) {
+					if _, err := e.plugins.RunAction(name); err != nil {
+						e.showError(fmt.Sprintf("plugin action %q failed: %v", name, err))
+					}
+				},
+			})
+		}
+	}
+
+	for _, info := range e.themeManager.GetAvailableThemes() {
+		info := info
+		candidates = append(candidates, paletteCandidate{
+			label: fmt.Sprintf("Theme: %s", info.DisplayName),
+			run: func(e *Editor) {
+				if !e.themeManager.SetTheme(info.Name) {
+					return
+				}
+				e.applyThemeToTextArea()
+				e.applyThemeToUI(e.themeManager.GetCurrentTheme())
+				e.showMessage(fmt.Sprintf("Switched to: %s", info.DisplayName))
+				e.render()
+			},
+		})
+	}
+
+	for _, path := range loadRecentFiles() {
+		path := path
+		candidates = append(candidates, paletteCandidate{
+			label: fmt.Sprintf("Open: %s", path),
+			run: func(e *Editor) {
+				if err := e.openFile(path); err != nil {
+					e.showError(fmt.Sprintf("Failed to open %s: %v", path, err))
+				}
+			},
+		})
+	}
+
+	for _, heading := range parseHeadings(e.activeTextArea().GetText()) {
+		heading := heading
+		candidates = append(candidates, paletteCandidate{
+			label: fmt.Sprintf("Go to: %s", heading.text),
+			run: func(e *Editor) {
+				e.activeTextArea().Select(heading.offset, heading.offset)
+				e.updateStatus()
+				e.render()
+			},
+		})
+	}
+
+	return candidates
+}
+
+// headingPattern matches ATX-style Markdown headings ("# Title").
+var headingPattern = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+
+type paletteHeading struct {
+	text   string
+	offset int
+}
+
+// parseHeadings scans content for ATX headings, recording each one's byte
+// offset so the palette can jump the cursor straight to it.
+func parseHeadings(content string) []paletteHeading {
+	var headings []paletteHeading
+
+	offset := 0
+	for _, line := range strings.Split(content, "\n") {
+		if m := headingPattern.FindStringSubmatch(line); m != nil {
+			headings = append(headings, paletteHeading{text: strings.TrimSpace(m[2]), offset: offset})
+		}
+		offset += len(line) + 1
+	}
+
+	return headings
+}
+
+// rankPaletteCandidates fuzzy-matches every candidate against query,
+// dropping anything that doesn't contain query as a subsequence, then sorts
+// by score and (on ties) by how recently it was chosen from the palette.
+func rankPaletteCandidates(candidates []paletteCandidate, query string, historyRank map[string]int, highlightColor string) []paletteMatch {
+	matches := make([]paletteMatch, 0, len(candidates))
+
+	for _, c := range candidates {
+		if query == "" {
+			matches = append(matches, paletteMatch{candidate: c, highlighted: tview.Escape(c.label)})
+			continue
+		}
+
+		score, positions, ok := fuzzyMatch(query, c.label)
+		if !ok {
+			continue
+		}
+
+		matches = append(matches, paletteMatch{
+			candidate:   c,
+			highlighted: highlightMatches(c.label, positions, highlightColor),
+			score:       score,
+		})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		ri, oki := historyRank[matches[i].candidate.label]
+		rj, okj := historyRank[matches[j].candidate.label]
+		if oki && okj {
+			return ri < rj
+		}
+		if oki != okj {
+			return oki
+		}
+		return matches[i].candidate.label < matches[j].candidate.label
+	})
+
+	if len(matches) > paletteMaxResults {
+		matches = matches[:paletteMaxResults]
+	}
+
+	return matches
+}
+
+// highlightMatches wraps the runes of label at positions in the theme's
+// info color, using tview's inline color tag syntax.
+func highlightMatches(label string, positions []int, colorCode string) string {
+	if len(positions) == 0 {
+		return tview.Escape(label)
+	}
+
+	marked := make(map[int]bool, len(positions))
+	for _, p := range positions {
+		marked[p] = true
+	}
+
+	var b strings.Builder
+	open := false
+	for i, r := range []rune(label) {
+		if marked[i] {
+			if !open {
+				b.WriteString("[" + colorCode + "]")
+				open = true
+			}
+		} else if open {
+			b.WriteString("[-]")
+			open = false
+		}
+		b.WriteString(tview.Escape(string(r)))
+	}
+	if open {
+		b.WriteString("[-]")
+	}
+
+	return b.String()
+}
+
+// Fuzzy matching, scored similarly to fzf: the best-scoring subsequence of
+// query's characters within target, rewarding boundary/camelCase starts and
+// consecutive runs, penalizing gaps between matched characters.
+const (
+	scoreMatch        = 16
+	scoreGapStart     = -3
+	scoreGapExtension = -1
+	bonusBoundary     = 8
+	bonusFirstChar    = 16
+	bonusCamel        = 7
+	bonusConsecutive  = 4
+)
+
+// fuzzyMatch finds the highest-scoring way to match query, in order, as a
+// subsequence of target. It returns false if any query character is
+// missing from target.
+func fuzzyMatch(query, target string) (int, []int, bool) {
+	if query == "" {
+		return 0, nil, true
+	}
+
+	q := []rune(strings.ToLower(query))
+	t := []rune(target)
+	tl := []rune(strings.ToLower(target))
+	n, m := len(q), len(t)
+	if m < n {
+		return 0, nil, false
+	}
+
+	score := make([][]int, n)
+	back := make([][]int, n)
+	for i := range score {
+		score[i] = make([]int, m)
+		back[i] = make([]int, m)
+		for j := range score[i] {
+			score[i][j] = math.MinInt32
+			back[i][j] = -1
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i; j < m; j++ {
+			if tl[j] != q[i] {
+				continue
+			}
+
+			bonus := boundaryBonus(t, j)
+			if i == 0 {
+				score[i][j] = scoreMatch + bonus
+				continue
+			}
+
+			best := math.MinInt32
+			bestPrev := -1
+			for k := i - 1; k < j; k++ {
+				if score[i-1][k] == math.MinInt32 {
+					continue
+				}
+				gap := j - k - 1
+				s := score[i-1][k] + scoreMatch + bonus
+				if gap == 0 {
+					s += bonusConsecutive
+				} else {
+					s += scoreGapStart + gap*scoreGapExtension
+				}
+				if s > best {
+					best = s
+					bestPrev = k
+				}
+			}
+			score[i][j] = best
+			back[i][j] = bestPrev
+		}
+	}
+
+	bestScore := math.MinInt32
+	bestEnd := -1
+	for j := 0; j < m; j++ {
+		if score[n-1][j] > bestScore {
+			bestScore = score[n-1][j]
+			bestEnd = j
+		}
+	}
+	if bestEnd < 0 {
+		return 0, nil, false
+	}
+
+	positions := make([]int, n)
+	j := bestEnd
+	for i := n - 1; i >= 0; i-- {
+		positions[i] = j
+		j = back[i][j]
+	}
+
+	return bestScore, positions, true
+}
+
+// boundaryBonus rewards matches at the start of the string, after a path or
+// word separator, or at a camelCase hump.
+func boundaryBonus(t []rune, j int) int {
+	if j == 0 {
+		return bonusFirstChar
+	}
+
+	prev := t[j-1]
+	switch prev {
+	case '/', '_', '-', ' ', '.', ':':
+		return bonusBoundary
+	}
+
+	if unicode.IsLower(prev) && unicode.IsUpper(t[j]) {
+		return bonusCamel
+	}
+
+	return 0
+}
+
+// Recent files and palette history, persisted under
+// ~/.config/writers-cli/.
+
+func writersConfigDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "writers-cli"), nil
+}
+
+// stylesetsDir returns ~/.config/writers-cli/stylesets, where F2's theme
+// cycle picks up user-supplied styleset files from.
+func stylesetsDir() (string, error) {
+	dir, err := writersConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "stylesets"), nil
+}
+
+// pluginsDir returns ~/.config/writers-cli/plugins, loaded at startup for
+// user-supplied Lua plugins.
+func pluginsDir() (string, error) {
+	dir, err := writersConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "plugins"), nil
+}
+
+// themesDir returns ~/.config/writers-cli/themes, where user-supplied
+// TOML/YAML theme files are loaded from alongside the built-in themes.
+func themesDir() (string, error) {
+	dir, err := writersConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "themes"), nil
+}
+
+// colorschemesDir returns ~/.config/writers-cli/colorschemes, where
+// "writers theme import" saves imported Vim/Neovim and micro colorscheme
+// files so they're available alongside the built-in themes on future runs.
+func colorschemesDir() (string, error) {
+	dir, err := writersConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "colorschemes"), nil
+}
+
+func recentFilesPath() (string, error) {
+	dir, err := writersConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, recentFilesFileName), nil
+}
+
+func paletteHistoryPath() (string, error) {
+	dir, err := writersConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, paletteHistoryFile), nil
+}
+
+// readLines reads path and splits it into non-empty lines, in file order.
+func readLines(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
+
+// appendLine appends line to the file at path, creating its parent
+// directory and the file itself as needed.
+func appendLine(pathFn func() (string, error), line string) {
+	path, err := pathFn()
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	fmt.Fprintln(f, line)
+}
+
+// writeLines overwrites the file at path with lines.
+func writeLines(pathFn func() (string, error), lines []string) {
+	path, err := pathFn()
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return
+	}
+
+	os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644)
+}
+
+// loadRecentFiles returns recently opened files, most recent first, deduped
+// and capped at recentFilesLimit.
+func loadRecentFiles() []string {
+	path, err := recentFilesPath()
+	if err != nil {
+		return nil
+	}
+	lines, err := readLines(path)
+	if err != nil {
+		return nil
+	}
+	return dedupeKeepLast(lines, recentFilesLimit)
+}
+
+// recordRecentFile appends path (made absolute) to the recent-files list.
+func recordRecentFile(path string) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		abs = path
+	}
+	appendLine(recentFilesPath, abs)
+}
+
+// dedupeKeepLast returns the last occurrence of each line, most recent
+// first, capped at limit entries.
+func dedupeKeepLast(lines []string, limit int) []string {
+	seen := make(map[string]bool, len(lines))
+	result := make([]string, 0, limit)
+
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := lines[i]
+		if seen[line] {
+			continue
+		}
+		seen[line] = true
+		result = append(result, line)
+		if len(result) >= limit {
+			break
+		}
+	}
+
+	return result
+}
+
+// loadPaletteHistory returns the palette's invocation history, oldest
+// first, truncating the on-disk file to the last paletteHistoryLimit
+// entries if it has grown beyond that (the "append-then-truncate" scheme:
+// each selection is appended cheaply, and the file is only rewritten when
+// it needs trimming).
+func loadPaletteHistory() []string {
+	path, err := paletteHistoryPath()
+	if err != nil {
+		return nil
+	}
+
+	lines, err := readLines(path)
+	if err != nil {
+		return nil
+	}
+
+	if len(lines) > paletteHistoryLimit {
+		lines = lines[len(lines)-paletteHistoryLimit:]
+		writeLines(paletteHistoryPath, lines)
+	}
+
+	return lines
+}
+
+// recordPaletteHistory appends label to the palette history file.
+func recordPaletteHistory(label string) {
+	appendLine(paletteHistoryPath, label)
+}
@@ -0,0 +1,188 @@
+package editor
+
+import "testing"
+
+func TestFuzzyMatchEmptyQueryMatchesEverything(t *testing.T) {
+	score, positions, ok := fuzzyMatch("", "anything")
+	if !ok {
+		t.Fatal("expected empty query to always match")
+	}
+	if score != 0 || positions != nil {
+		t.Errorf("fuzzyMatch(\"\", ...) = %d, %v, want 0, nil", score, positions)
+	}
+}
+
+func TestFuzzyMatchMissingCharacterFails(t *testing.T) {
+	if _, _, ok := fuzzyMatch("xyz", "abc"); ok {
+		t.Fatal("expected no match when a query character is missing from target")
+	}
+}
+
+func TestFuzzyMatchTargetShorterThanQueryFails(t *testing.T) {
+	if _, _, ok := fuzzyMatch("abcdef", "ab"); ok {
+		t.Fatal("expected no match when target is shorter than query")
+	}
+}
+
+func TestFuzzyMatchIsCaseInsensitive(t *testing.T) {
+	if _, _, ok := fuzzyMatch("ABC", "abcdef"); !ok {
+		t.Fatal("expected case-insensitive match")
+	}
+}
+
+func TestFuzzyMatchFindsSubsequencePositions(t *testing.T) {
+	_, positions, ok := fuzzyMatch("ace", "abcde")
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	want := []int{0, 2, 4}
+	if len(positions) != len(want) {
+		t.Fatalf("positions = %v, want %v", positions, want)
+	}
+	for i := range want {
+		if positions[i] != want[i] {
+			t.Fatalf("positions = %v, want %v", positions, want)
+		}
+	}
+}
+
+func TestFuzzyMatchPrefersConsecutiveOverScattered(t *testing.T) {
+	scoreConsecutive, _, ok := fuzzyMatch("new", "newfile.md")
+	if !ok {
+		t.Fatal("expected a match against newfile.md")
+	}
+	scoreScattered, _, ok := fuzzyMatch("new", "not-every-word.md")
+	if !ok {
+		t.Fatal("expected a match against not-every-word.md")
+	}
+	if scoreConsecutive <= scoreScattered {
+		t.Errorf("consecutive match score %d should beat scattered match score %d", scoreConsecutive, scoreScattered)
+	}
+}
+
+func TestFuzzyMatchRewardsWordBoundaryStart(t *testing.T) {
+	scoreBoundary, _, ok := fuzzyMatch("f", "my-file.md")
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	scoreMid, _, ok := fuzzyMatch("f", "myafile.md")
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	if scoreBoundary <= scoreMid {
+		t.Errorf("boundary match score %d should beat mid-word match score %d", scoreBoundary, scoreMid)
+	}
+}
+
+func TestBoundaryBonusFirstChar(t *testing.T) {
+	if got := boundaryBonus([]rune("hello"), 0); got != bonusFirstChar {
+		t.Errorf("boundaryBonus at index 0 = %d, want %d", got, bonusFirstChar)
+	}
+}
+
+func TestBoundaryBonusSeparator(t *testing.T) {
+	for _, r := range []rune{'/', '_', '-', ' ', '.', ':'} {
+		target := []rune(string(r) + "x")
+		if got := boundaryBonus(target, 1); got != bonusBoundary {
+			t.Errorf("boundaryBonus after %q = %d, want %d", r, got, bonusBoundary)
+		}
+	}
+}
+
+func TestBoundaryBonusCamelCase(t *testing.T) {
+	if got := boundaryBonus([]rune("myFile"), 2); got != bonusCamel {
+		t.Errorf("boundaryBonus at camelCase hump = %d, want %d", got, bonusCamel)
+	}
+}
+
+func TestBoundaryBonusNone(t *testing.T) {
+	if got := boundaryBonus([]rune("hello"), 1); got != 0 {
+		t.Errorf("boundaryBonus mid-word = %d, want 0", got)
+	}
+}
+
+func TestHighlightMatchesWrapsPositions(t *testing.T) {
+	got := highlightMatches("abc", []int{0, 2}, "yellow")
+	want := "[yellow]a[-]b[yellow]c[-]"
+	if got != want {
+		t.Errorf("highlightMatches() = %q, want %q", got, want)
+	}
+}
+
+func TestHighlightMatchesNoPositionsEscapesOnly(t *testing.T) {
+	got := highlightMatches("abc", nil, "yellow")
+	if got != "abc" {
+		t.Errorf("highlightMatches() = %q, want %q", got, "abc")
+	}
+}
+
+func TestRankPaletteCandidatesFiltersNonMatches(t *testing.T) {
+	candidates := []paletteCandidate{
+		{label: "Open File"},
+		{label: "Close Editor"},
+		{label: "Save"},
+	}
+
+	matches := rankPaletteCandidates(candidates, "open", nil, "yellow")
+	if len(matches) != 1 {
+		t.Fatalf("expected 1 match, got %d: %+v", len(matches), matches)
+	}
+	if matches[0].candidate.label != "Open File" {
+		t.Errorf("matched label = %q, want %q", matches[0].candidate.label, "Open File")
+	}
+}
+
+func TestRankPaletteCandidatesEmptyQueryKeepsAll(t *testing.T) {
+	candidates := []paletteCandidate{
+		{label: "Open File"},
+		{label: "Close Editor"},
+	}
+
+	matches := rankPaletteCandidates(candidates, "", nil, "yellow")
+	if len(matches) != 2 {
+		t.Fatalf("expected all candidates kept for an empty query, got %d", len(matches))
+	}
+}
+
+func TestRankPaletteCandidatesSortsByScoreDescending(t *testing.T) {
+	candidates := []paletteCandidate{
+		{label: "not-every-word.md"},
+		{label: "newfile.md"},
+	}
+
+	matches := rankPaletteCandidates(candidates, "new", nil, "yellow")
+	if len(matches) != 2 {
+		t.Fatalf("expected 2 matches, got %d", len(matches))
+	}
+	if matches[0].candidate.label != "newfile.md" {
+		t.Errorf("expected the higher-scoring consecutive match to rank first, got %q", matches[0].candidate.label)
+	}
+	if matches[0].score < matches[1].score {
+		t.Errorf("matches not sorted by descending score: %+v", matches)
+	}
+}
+
+func TestRankPaletteCandidatesTieBreaksOnHistoryRank(t *testing.T) {
+	candidates := []paletteCandidate{
+		{label: "alpha"},
+		{label: "beta"},
+	}
+	historyRank := map[string]int{"beta": 0, "alpha": 1}
+
+	matches := rankPaletteCandidates(candidates, "", historyRank, "yellow")
+	if matches[0].candidate.label != "beta" {
+		t.Errorf("expected beta (lower history rank) to sort first, got %q", matches[0].candidate.label)
+	}
+}
+
+func TestRankPaletteCandidatesCapsAtMaxResults(t *testing.T) {
+	candidates := make([]paletteCandidate, paletteMaxResults+5)
+	for i := range candidates {
+		candidates[i] = paletteCandidate{label: "item"}
+	}
+
+	matches := rankPaletteCandidates(candidates, "", nil, "yellow")
+	if len(matches) != paletteMaxResults {
+		t.Errorf("expected results capped at %d, got %d", paletteMaxResults, len(matches))
+	}
+}
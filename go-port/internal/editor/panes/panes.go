@@ -0,0 +1,282 @@
+// Package panes implements the editor's splittable pane layout: a binary
+// tree of leaves, each a standalone editing surface, joined by horizontal or
+// vertical splits with an adjustable ratio.
+package panes
+
+import (
+	"github.com/rivo/tview"
+
+	"writers-cli/internal/editor/history"
+)
+
+// Orientation is the direction a split divides its parent rectangle.
+type Orientation int
+
+const (
+	// Horizontal splits place First and Second side by side (left/right).
+	Horizontal Orientation = iota
+	// Vertical splits stack First above Second (top/bottom).
+	Vertical
+)
+
+// MinPaneSize is the smallest width or height, in terminal cells, a pane is
+// allowed to shrink to when resizing.
+const MinPaneSize = 4
+
+// DefaultResizeStep is the fraction of a split's ratio adjusted by a single
+// resize action.
+const DefaultResizeStep = 0.05
+
+// minRatio bounds how far a split's ratio can move towards either edge, so
+// neither side of a split collapses to nothing.
+const minRatio = 0.1
+
+// Leaf is a single editing surface: its own text area, backing file, dirty
+// flag, cursor position, and undo/redo history. Splitting a pane never
+// shares this state between panes.
+type Leaf struct {
+	TextArea  *tview.TextArea
+	File      string
+	Dirty     bool
+	CursorRow int
+	CursorCol int
+
+	// History is this pane's independent undo/redo stack.
+	History *history.EventHandler
+
+	// LastText is the text area's content as of the last recorded edit,
+	// used to diff against its current content when it next changes.
+	LastText string
+
+	// LastWordCount is this pane's word count as of the last edit, used to
+	// detect when it actually changes.
+	LastWordCount int
+
+	// JustEdited is set while an edit's own cursor movement is still being
+	// reported, so that movement isn't mistaken for a navigation-driven
+	// cursor jump and doesn't needlessly break the undo group.
+	JustEdited bool
+}
+
+// NewLeaf wraps textArea as a freshly created, unsplit pane.
+func NewLeaf(textArea *tview.TextArea) *Node {
+	return &Node{Leaf: newLeaf(textArea)}
+}
+
+func newLeaf(textArea *tview.TextArea) *Leaf {
+	return &Leaf{TextArea: textArea, History: history.NewEventHandler(0)}
+}
+
+// Node is one node of the pane tree: either a leaf (Leaf set, First/Second
+// nil) or a split (First/Second set, Leaf nil).
+type Node struct {
+	Leaf *Leaf
+
+	Orientation Orientation
+	Ratio       float64
+	First       *Node
+	Second      *Node
+	Parent      *Node
+}
+
+// IsLeaf reports whether n is an editing surface rather than a split.
+func (n *Node) IsLeaf() bool {
+	return n.Leaf != nil
+}
+
+// Split turns the leaf n into a split along orientation, keeping n's
+// existing content as First and a new, empty pane wrapping textArea as
+// Second. It returns the new pane, which becomes the natural next pane to
+// focus.
+func (n *Node) Split(orientation Orientation, textArea *tview.TextArea) *Node {
+	first := &Node{Leaf: n.Leaf, Parent: n}
+	second := &Node{Leaf: newLeaf(textArea), Parent: n}
+
+	n.Leaf = nil
+	n.Orientation = orientation
+	n.Ratio = 0.5
+	n.First = first
+	n.Second = second
+
+	return second
+}
+
+// Close removes leaf n from the tree, promoting its sibling into n's
+// parent's place. It returns the pane that should become focused afterwards
+// and false if n was the only pane left (in which case nothing is closed).
+func (n *Node) Close() (*Node, bool) {
+	parent := n.Parent
+	if parent == nil {
+		return n, false
+	}
+
+	sibling := parent.First
+	if sibling == n {
+		sibling = parent.Second
+	}
+
+	grandparent := parent.Parent
+	*parent = *sibling
+	parent.Parent = grandparent
+	if !parent.IsLeaf() {
+		parent.First.Parent = parent
+		parent.Second.Parent = parent
+	}
+
+	return parent.FirstLeaf(), true
+}
+
+// FirstLeaf descends through First children until it reaches a leaf.
+func (n *Node) FirstLeaf() *Node {
+	for !n.IsLeaf() {
+		n = n.First
+	}
+	return n
+}
+
+// Leaves returns every leaf under n, in left-to-right / top-to-bottom
+// document order.
+func (n *Node) Leaves() []*Node {
+	if n.IsLeaf() {
+		return []*Node{n}
+	}
+	return append(n.First.Leaves(), n.Second.Leaves()...)
+}
+
+// Root walks up from n to the tree's root node.
+func (n *Node) Root() *Node {
+	for n.Parent != nil {
+		n = n.Parent
+	}
+	return n
+}
+
+// Next returns the leaf after active in root's document order, wrapping
+// around to the first leaf.
+func Next(root, active *Node) *Node {
+	return adjacent(root, active, 1)
+}
+
+// Prev returns the leaf before active in root's document order, wrapping
+// around to the last leaf.
+func Prev(root, active *Node) *Node {
+	return adjacent(root, active, -1)
+}
+
+func adjacent(root, active *Node, delta int) *Node {
+	leaves := root.Leaves()
+	if len(leaves) == 0 {
+		return active
+	}
+
+	index := 0
+	for i, leaf := range leaves {
+		if leaf == active {
+			index = i
+			break
+		}
+	}
+
+	next := (index + delta + len(leaves)) % len(leaves)
+	return leaves[next]
+}
+
+// Rect is an axis-aligned rectangle of terminal cells.
+type Rect struct {
+	X, Y, Width, Height int
+}
+
+// Layout recomputes the rectangle occupied by every leaf under n, given
+// that n itself occupies rect. It is a pure function of the tree's ratios,
+// independent of how the caller actually renders each pane.
+func (n *Node) Layout(rect Rect) map[*Node]Rect {
+	rects := make(map[*Node]Rect)
+	n.layout(rect, rects)
+	return rects
+}
+
+func (n *Node) layout(rect Rect, rects map[*Node]Rect) {
+	if n.IsLeaf() {
+		rects[n] = rect
+		return
+	}
+
+	first, second := splitRect(rect, n.Orientation, n.Ratio)
+	n.First.layout(first, rects)
+	n.Second.layout(second, rects)
+}
+
+// splitRect divides rect into two along orientation at ratio (the fraction
+// given to the first half), clamping each half to at least MinPaneSize.
+func splitRect(rect Rect, orientation Orientation, ratio float64) (Rect, Rect) {
+	if orientation == Horizontal {
+		firstWidth := clampSize(int(float64(rect.Width)*ratio), rect.Width)
+		first := Rect{X: rect.X, Y: rect.Y, Width: firstWidth, Height: rect.Height}
+		second := Rect{X: rect.X + firstWidth, Y: rect.Y, Width: rect.Width - firstWidth, Height: rect.Height}
+		return first, second
+	}
+
+	firstHeight := clampSize(int(float64(rect.Height)*ratio), rect.Height)
+	first := Rect{X: rect.X, Y: rect.Y, Width: rect.Width, Height: firstHeight}
+	second := Rect{X: rect.X, Y: rect.Y + firstHeight, Width: rect.Width, Height: rect.Height - firstHeight}
+	return first, second
+}
+
+// clampSize keeps a computed pane dimension within [MinPaneSize, total-MinPaneSize].
+func clampSize(size, total int) int {
+	if size < MinPaneSize {
+		size = MinPaneSize
+	}
+	if max := total - MinPaneSize; size > max && max >= MinPaneSize {
+		size = max
+	}
+	return size
+}
+
+// NearestSplit returns n's closest ancestor split oriented o, or nil if
+// there isn't one.
+func (n *Node) NearestSplit(o Orientation) *Node {
+	for p := n.Parent; p != nil; p = p.Parent {
+		if p.Orientation == o {
+			return p
+		}
+	}
+	return nil
+}
+
+// ResizeAlong grows (delta > 0) or shrinks (delta < 0) n's share of its
+// nearest ancestor split oriented o, clamped so neither side collapses. It
+// reports whether a matching ancestor split was found.
+func (n *Node) ResizeAlong(o Orientation, delta float64) bool {
+	split := n.NearestSplit(o)
+	if split == nil {
+		return false
+	}
+
+	sign := 1.0
+	if !isFirstDescendant(split, n) {
+		sign = -1.0
+	}
+
+	ratio := split.Ratio + sign*delta
+	if ratio < minRatio {
+		ratio = minRatio
+	}
+	if ratio > 1-minRatio {
+		ratio = 1 - minRatio
+	}
+	split.Ratio = ratio
+
+	return true
+}
+
+// isFirstDescendant reports whether n descends from split.First, as opposed
+// to split.Second.
+func isFirstDescendant(split, n *Node) bool {
+	for cur := n; cur != nil; cur = cur.Parent {
+		if cur.Parent == split {
+			return cur == split.First
+		}
+	}
+	return false
+}
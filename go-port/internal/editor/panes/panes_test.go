@@ -0,0 +1,265 @@
+package panes
+
+import "testing"
+
+func TestSplitKeepsOriginalContentAsFirst(t *testing.T) {
+	root := NewLeaf(nil)
+	root.Leaf.File = "a.md"
+
+	second := root.Split(Horizontal, nil)
+
+	if root.IsLeaf() {
+		t.Fatal("expected root to become a split")
+	}
+	if root.First.Leaf.File != "a.md" {
+		t.Errorf("First.Leaf.File = %q, want %q", root.First.Leaf.File, "a.md")
+	}
+	if root.Second != second {
+		t.Error("Split() should return the new Second pane")
+	}
+	if root.First.Parent != root || root.Second.Parent != root {
+		t.Error("First/Second Parent pointers should point back to root")
+	}
+}
+
+func TestCloseLeafPromotesSiblingOnSecondSide(t *testing.T) {
+	root := NewLeaf(nil)
+	root.Leaf.File = "a.md"
+	second := root.Split(Horizontal, nil)
+	second.Leaf.File = "b.md"
+
+	focused, ok := second.Close()
+	if !ok {
+		t.Fatal("expected Close() to succeed")
+	}
+
+	if !root.IsLeaf() {
+		t.Fatal("expected root to become a leaf again after closing its only sibling")
+	}
+	if root.Leaf.File != "a.md" {
+		t.Errorf("root.Leaf.File = %q, want %q (sibling should have been promoted)", root.Leaf.File, "a.md")
+	}
+	if root.Parent != nil {
+		t.Error("promoted root should have no parent")
+	}
+	if focused != root {
+		t.Error("Close() should return the promoted pane as the next focus")
+	}
+}
+
+func TestCloseLeafPromotesSiblingOnFirstSide(t *testing.T) {
+	root := NewLeaf(nil)
+	root.Leaf.File = "a.md"
+	second := root.Split(Horizontal, nil)
+	second.Leaf.File = "b.md"
+	first := root.First
+
+	focused, ok := first.Close()
+	if !ok {
+		t.Fatal("expected Close() to succeed")
+	}
+
+	if !root.IsLeaf() {
+		t.Fatal("expected root to become a leaf again after closing its only sibling")
+	}
+	if root.Leaf.File != "b.md" {
+		t.Errorf("root.Leaf.File = %q, want %q (sibling should have been promoted)", root.Leaf.File, "b.md")
+	}
+	if focused != root {
+		t.Error("Close() should return the promoted pane as the next focus")
+	}
+}
+
+func TestCloseOnlyPaneIsNoop(t *testing.T) {
+	root := NewLeaf(nil)
+
+	focused, ok := root.Close()
+	if ok {
+		t.Fatal("expected Close() to report false when n is the only pane")
+	}
+	if focused != root {
+		t.Error("Close() should return n unchanged when there is nothing to close")
+	}
+}
+
+// TestCloseAtDepthRelinksGrandparent builds a three-pane tree:
+//
+//	root
+//	├── a (First)
+//	└── split (Second)
+//	    ├── b (First)
+//	    └── c (Second)
+//
+// and closes "b", which should splice "split" out of the tree entirely,
+// promoting "c" directly into root.Second's place with root as its parent.
+func TestCloseAtDepthRelinksGrandparent(t *testing.T) {
+	root := NewLeaf(nil)
+	root.Leaf.File = "a.md"
+	split := root.Split(Horizontal, nil)
+	split.Leaf.File = "b.md"
+	c := split.Split(Vertical, nil)
+	c.Leaf.File = "c.md"
+	b := split.First
+
+	focused, ok := b.Close()
+	if !ok {
+		t.Fatal("expected Close() to succeed")
+	}
+
+	if root.Second.Leaf == nil || root.Second.Leaf.File != "c.md" {
+		t.Fatalf("root.Second should now be leaf c.md, got %+v", root.Second)
+	}
+	if root.Second.Parent != root {
+		t.Error("promoted leaf's Parent should point to root, not the removed split")
+	}
+	if focused != root.Second {
+		t.Error("Close() should return the promoted leaf as the next focus")
+	}
+
+	leaves := root.Leaves()
+	if len(leaves) != 2 {
+		t.Fatalf("expected 2 leaves remaining, got %d", len(leaves))
+	}
+}
+
+// TestCloseAtDepthKeepsSplitSiblingIntact closes a leaf whose sibling is
+// itself a split, verifying the split's own children are relinked to their
+// new parent rather than left pointing at the removed intermediate node.
+func TestCloseAtDepthKeepsSplitSiblingIntact(t *testing.T) {
+	root := NewLeaf(nil)
+	root.Leaf.File = "a.md"
+	inner := root.Split(Horizontal, nil)
+	inner.Leaf.File = "b.md"
+	d := inner.Split(Vertical, nil)
+	d.Leaf.File = "d.md"
+	// Now: root.First = a (leaf), root.Second = inner (split: First=b, Second=d)
+
+	a := root.First
+	focused, ok := a.Close()
+	if !ok {
+		t.Fatal("expected Close() to succeed")
+	}
+
+	if root.IsLeaf() {
+		t.Fatal("expected root to remain a split (inner's subtree promoted into it)")
+	}
+	if root.First.Leaf == nil || root.First.Leaf.File != "b.md" {
+		t.Fatalf("root.First should be leaf b.md, got %+v", root.First)
+	}
+	if root.Second.Leaf == nil || root.Second.Leaf.File != "d.md" {
+		t.Fatalf("root.Second should be leaf d.md, got %+v", root.Second)
+	}
+	if root.First.Parent != root || root.Second.Parent != root {
+		t.Error("promoted split's children should have their Parent repointed to root")
+	}
+	if focused != root.First {
+		t.Errorf("Close() should return the promoted split's first leaf, got %+v", focused)
+	}
+}
+
+func TestLayoutSplitsRectByRatio(t *testing.T) {
+	root := NewLeaf(nil)
+	second := root.Split(Horizontal, nil)
+	root.Ratio = 0.25
+
+	rects := root.Layout(Rect{X: 0, Y: 0, Width: 100, Height: 40})
+
+	first := rects[root.First]
+	if first.Width != 25 {
+		t.Errorf("first.Width = %d, want 25", first.Width)
+	}
+	got := rects[second]
+	if got.X != 25 || got.Width != 75 {
+		t.Errorf("second rect = %+v, want X=25 Width=75", got)
+	}
+}
+
+func TestSplitRectClampsToMinPaneSize(t *testing.T) {
+	first, second := splitRect(Rect{Width: 10, Height: 20}, Horizontal, 0.01)
+	if first.Width != MinPaneSize {
+		t.Errorf("first.Width = %d, want %d (clamped to MinPaneSize)", first.Width, MinPaneSize)
+	}
+	if second.Width != 10-MinPaneSize {
+		t.Errorf("second.Width = %d, want %d", second.Width, 10-MinPaneSize)
+	}
+}
+
+func TestSplitRectClampsOppositeEdge(t *testing.T) {
+	first, second := splitRect(Rect{Width: 10, Height: 20}, Horizontal, 0.99)
+	if first.Width != 10-MinPaneSize {
+		t.Errorf("first.Width = %d, want %d (clamped so second keeps MinPaneSize)", first.Width, 10-MinPaneSize)
+	}
+	if second.Width != MinPaneSize {
+		t.Errorf("second.Width = %d, want %d", second.Width, MinPaneSize)
+	}
+}
+
+func TestSplitRectVerticalDividesHeight(t *testing.T) {
+	first, second := splitRect(Rect{Width: 10, Height: 20}, Vertical, 0.5)
+	if first.Height != 10 || second.Height != 10 {
+		t.Errorf("first/second heights = %d/%d, want 10/10", first.Height, second.Height)
+	}
+	if first.Width != 10 || second.Width != 10 {
+		t.Errorf("first/second widths = %d/%d, want 10/10 (unsplit axis unchanged)", first.Width, second.Width)
+	}
+}
+
+func TestResizeAlongGrowsFirstDescendant(t *testing.T) {
+	root := NewLeaf(nil)
+	root.Split(Horizontal, nil)
+	root.Ratio = 0.5
+
+	if ok := root.First.ResizeAlong(Horizontal, 0.1); !ok {
+		t.Fatal("expected ResizeAlong to find the ancestor split")
+	}
+	if root.Ratio != 0.6 {
+		t.Errorf("Ratio = %v, want 0.6", root.Ratio)
+	}
+}
+
+func TestResizeAlongGrowingSecondShrinksRatio(t *testing.T) {
+	root := NewLeaf(nil)
+	second := root.Split(Horizontal, nil)
+	root.Ratio = 0.5
+
+	if ok := second.ResizeAlong(Horizontal, 0.1); !ok {
+		t.Fatal("expected ResizeAlong to find the ancestor split")
+	}
+	if root.Ratio != 0.4 {
+		t.Errorf("Ratio = %v, want 0.4 (growing Second shrinks the First/Second ratio)", root.Ratio)
+	}
+}
+
+func TestResizeAlongClampsAtMinRatio(t *testing.T) {
+	root := NewLeaf(nil)
+	root.Split(Horizontal, nil)
+	root.Ratio = 0.5
+
+	root.Second.ResizeAlong(Horizontal, 10)
+
+	if root.Ratio != minRatio {
+		t.Errorf("Ratio = %v, want clamped to minRatio %v", root.Ratio, minRatio)
+	}
+}
+
+func TestResizeAlongClampsAtMaxRatio(t *testing.T) {
+	root := NewLeaf(nil)
+	root.Split(Horizontal, nil)
+	root.Ratio = 0.5
+
+	root.First.ResizeAlong(Horizontal, 10)
+
+	want := 1 - minRatio
+	if root.Ratio != want {
+		t.Errorf("Ratio = %v, want clamped to 1-minRatio %v", root.Ratio, want)
+	}
+}
+
+func TestResizeAlongNoMatchingAncestorReturnsFalse(t *testing.T) {
+	root := NewLeaf(nil)
+	root.Split(Horizontal, nil)
+
+	if ok := root.First.ResizeAlong(Vertical, 0.1); ok {
+		t.Fatal("expected ResizeAlong to report false when no ancestor split has that orientation")
+	}
+}
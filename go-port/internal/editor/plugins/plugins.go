@@ -0,0 +1,303 @@
+// Package plugins loads user-supplied Lua scripts that can hook into the
+// editor's lifecycle and register new named actions, via a small `writers`
+// module exposed to each script.
+package plugins
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	lua "github.com/yuin/gopher-lua"
+)
+
+// Hook identifies a point in the editor's lifecycle a plugin can react to.
+type Hook string
+
+const (
+	OnSave            Hook = "onSave"
+	OnOpen            Hook = "onOpen"
+	OnBeforeExit      Hook = "onBeforeExit"
+	OnWordCountChange Hook = "onWordCountChange"
+	OnModeChange      Hook = "onModeChange"
+	OnEdit            Hook = "onEdit"
+)
+
+// Host is the editor state and operations the writers Lua module exposes to
+// plugins. The editor package implements it; this package never imports
+// the editor package, so the dependency only runs one way.
+type Host interface {
+	GetText() string
+	SetText(text string)
+	InsertAtCursor(text string)
+	GetCursor() (row, col int)
+	SetCursor(row, col int)
+	ShowMessage(message string)
+	ShowError(message string)
+	GetConfig(key string) string
+	CurrentFile() string
+	WordCount() int
+}
+
+// Manager loads *.lua plugins into a single shared Lua state and dispatches
+// editor hooks and plugin-registered actions to them.
+type Manager struct {
+	state   *lua.LState
+	host    Host
+	hooks   map[Hook][]*lua.LFunction
+	actions map[string]*lua.LFunction
+}
+
+// NewManager creates a Manager whose writers Lua module operates on host.
+func NewManager(host Host) *Manager {
+	m := &Manager{
+		host:    host,
+		hooks:   make(map[Hook][]*lua.LFunction),
+		actions: make(map[string]*lua.LFunction),
+	}
+	m.state = lua.NewState()
+	m.registerWritersModule()
+	return m
+}
+
+// Close releases the plugin Lua state.
+func (m *Manager) Close() {
+	m.state.Close()
+}
+
+// LoadDir loads every *.lua file in dir, in name order. A missing dir isn't
+// an error - it just means no plugins are installed. A plugin that fails to
+// load doesn't stop the rest from loading; its error is returned alongside
+// the others.
+func (m *Manager) LoadDir(dir string) []error {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".lua") {
+			continue
+		}
+		if err := m.state.DoFile(filepath.Join(dir, entry.Name())); err != nil {
+			errs = append(errs, fmt.Errorf("plugin %s: %w", entry.Name(), err))
+		}
+	}
+	return errs
+}
+
+// HasAction reports whether a plugin has registered an action named name.
+func (m *Manager) HasAction(name string) bool {
+	_, ok := m.actions[name]
+	return ok
+}
+
+// ActionNames returns every plugin-registered action name, sorted, for
+// wiring into the keymap and command palette.
+func (m *Manager) ActionNames() []string {
+	names := make([]string, 0, len(m.actions))
+	for name := range m.actions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RunAction invokes the plugin-registered action named name. found reports
+// whether an action by that name exists at all.
+func (m *Manager) RunAction(name string) (found bool, err error) {
+	fn, ok := m.actions[name]
+	if !ok {
+		return false, nil
+	}
+
+	m.state.Push(fn)
+	if err := m.state.PCall(0, 0, nil); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// RunOnOpen runs every onOpen hook.
+func (m *Manager) RunOnOpen() {
+	m.runHooks(OnOpen)
+}
+
+// RunOnEdit runs every onEdit hook.
+func (m *Manager) RunOnEdit() {
+	m.runHooks(OnEdit)
+}
+
+// RunOnWordCountChange runs every onWordCountChange hook with the new word
+// count.
+func (m *Manager) RunOnWordCountChange(count int) {
+	m.runHooksWithArgs(OnWordCountChange, lua.LNumber(count))
+}
+
+// RunOnModeChange runs every onModeChange hook with the new mode's name.
+func (m *Manager) RunOnModeChange(mode string) {
+	m.runHooksWithArgs(OnModeChange, lua.LString(mode))
+}
+
+// RunOnSave runs every onSave hook in registration order, stopping as soon
+// as one returns false. It reports whether the save should proceed.
+func (m *Manager) RunOnSave() bool {
+	return m.runVetoHooks(OnSave)
+}
+
+// RunOnBeforeExit runs every onBeforeExit hook in registration order,
+// stopping as soon as one returns false. It reports whether the exit
+// should proceed.
+func (m *Manager) RunOnBeforeExit() bool {
+	return m.runVetoHooks(OnBeforeExit)
+}
+
+func (m *Manager) runHooks(hook Hook) {
+	for _, fn := range m.hooks[hook] {
+		m.call(fn)
+	}
+}
+
+func (m *Manager) runHooksWithArgs(hook Hook, args ...lua.LValue) {
+	for _, fn := range m.hooks[hook] {
+		m.call(fn, args...)
+	}
+}
+
+// runVetoHooks calls every function registered for hook in order, stopping
+// and reporting false as soon as one returns false. This is the convention
+// onSave and onBeforeExit use to veto the operation they guard.
+func (m *Manager) runVetoHooks(hook Hook) bool {
+	for _, fn := range m.hooks[hook] {
+		if !m.callVeto(fn) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Manager) call(fn *lua.LFunction, args ...lua.LValue) {
+	m.state.Push(fn)
+	for _, arg := range args {
+		m.state.Push(arg)
+	}
+	if err := m.state.PCall(len(args), 0, nil); err != nil {
+		m.host.ShowError(fmt.Sprintf("plugin error: %v", err))
+	}
+}
+
+// callVeto calls fn with no arguments and reports whether it returned
+// anything other than explicit false. A plugin error doesn't veto the
+// operation - a broken hook shouldn't be able to lock the editor up.
+func (m *Manager) callVeto(fn *lua.LFunction) bool {
+	m.state.Push(fn)
+	if err := m.state.PCall(0, 1, nil); err != nil {
+		m.host.ShowError(fmt.Sprintf("plugin error: %v", err))
+		return true
+	}
+
+	ret := m.state.Get(-1)
+	m.state.Pop(1)
+	return ret != lua.LFalse
+}
+
+// registerWritersModule installs the writers Lua module: data accessors
+// backed by Host, plus the hook and action registration functions plugins
+// call at load time.
+func (m *Manager) registerWritersModule() {
+	mod := m.state.NewTable()
+
+	m.state.SetField(mod, "GetText", m.state.NewFunction(m.luaGetText))
+	m.state.SetField(mod, "SetText", m.state.NewFunction(m.luaSetText))
+	m.state.SetField(mod, "InsertAtCursor", m.state.NewFunction(m.luaInsertAtCursor))
+	m.state.SetField(mod, "GetCursor", m.state.NewFunction(m.luaGetCursor))
+	m.state.SetField(mod, "SetCursor", m.state.NewFunction(m.luaSetCursor))
+	m.state.SetField(mod, "ShowMessage", m.state.NewFunction(m.luaShowMessage))
+	m.state.SetField(mod, "ShowError", m.state.NewFunction(m.luaShowError))
+	m.state.SetField(mod, "GetConfig", m.state.NewFunction(m.luaGetConfig))
+	m.state.SetField(mod, "CurrentFile", m.state.NewFunction(m.luaCurrentFile))
+	m.state.SetField(mod, "WordCount", m.state.NewFunction(m.luaWordCount))
+
+	m.state.SetField(mod, "OnSave", m.state.NewFunction(m.hookRegistrar(OnSave)))
+	m.state.SetField(mod, "OnOpen", m.state.NewFunction(m.hookRegistrar(OnOpen)))
+	m.state.SetField(mod, "OnBeforeExit", m.state.NewFunction(m.hookRegistrar(OnBeforeExit)))
+	m.state.SetField(mod, "OnWordCountChange", m.state.NewFunction(m.hookRegistrar(OnWordCountChange)))
+	m.state.SetField(mod, "OnModeChange", m.state.NewFunction(m.hookRegistrar(OnModeChange)))
+	m.state.SetField(mod, "OnEdit", m.state.NewFunction(m.hookRegistrar(OnEdit)))
+	m.state.SetField(mod, "RegisterAction", m.state.NewFunction(m.luaRegisterAction))
+
+	m.state.SetGlobal("writers", mod)
+}
+
+func (m *Manager) hookRegistrar(hook Hook) lua.LGFunction {
+	return func(L *lua.LState) int {
+		m.hooks[hook] = append(m.hooks[hook], L.CheckFunction(1))
+		return 0
+	}
+}
+
+func (m *Manager) luaRegisterAction(L *lua.LState) int {
+	name := L.CheckString(1)
+	m.actions[name] = L.CheckFunction(2)
+	return 0
+}
+
+func (m *Manager) luaGetText(L *lua.LState) int {
+	L.Push(lua.LString(m.host.GetText()))
+	return 1
+}
+
+func (m *Manager) luaSetText(L *lua.LState) int {
+	m.host.SetText(L.CheckString(1))
+	return 0
+}
+
+func (m *Manager) luaInsertAtCursor(L *lua.LState) int {
+	m.host.InsertAtCursor(L.CheckString(1))
+	return 0
+}
+
+func (m *Manager) luaGetCursor(L *lua.LState) int {
+	row, col := m.host.GetCursor()
+	L.Push(lua.LNumber(row))
+	L.Push(lua.LNumber(col))
+	return 2
+}
+
+func (m *Manager) luaSetCursor(L *lua.LState) int {
+	row := L.CheckInt(1)
+	col := L.CheckInt(2)
+	m.host.SetCursor(row, col)
+	return 0
+}
+
+func (m *Manager) luaShowMessage(L *lua.LState) int {
+	m.host.ShowMessage(L.CheckString(1))
+	return 0
+}
+
+func (m *Manager) luaShowError(L *lua.LState) int {
+	m.host.ShowError(L.CheckString(1))
+	return 0
+}
+
+func (m *Manager) luaGetConfig(L *lua.LState) int {
+	L.Push(lua.LString(m.host.GetConfig(L.CheckString(1))))
+	return 1
+}
+
+func (m *Manager) luaCurrentFile(L *lua.LState) int {
+	L.Push(lua.LString(m.host.CurrentFile()))
+	return 1
+}
+
+func (m *Manager) luaWordCount(L *lua.LState) int {
+	L.Push(lua.LNumber(m.host.WordCount()))
+	return 1
+}
@@ -0,0 +1,124 @@
+package editor
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"writers-cli/internal/vcs"
+)
+
+const historyPage = "file-history"
+
+// showFileHistory opens a modal listing the active file's commit history,
+// so writers can browse past revisions of a chapter without leaving the
+// editor. Selecting an entry shows that commit's diff against its parent.
+func (e *Editor) showFileHistory() {
+	leaf := e.active.Leaf
+	if leaf.File == "" {
+		e.showError("File history is only available for saved files")
+		return
+	}
+
+	root, err := vcs.Root(filepath.Dir(leaf.File))
+	if err != nil {
+		e.showError(fmt.Sprintf("Not a git repository: %v", err))
+		return
+	}
+
+	relFile, err := filepath.Rel(root, leaf.File)
+	if err != nil {
+		e.showError(fmt.Sprintf("Failed to resolve file path: %v", err))
+		return
+	}
+
+	commits, err := vcs.History(root, relFile)
+	if err != nil {
+		e.showError(fmt.Sprintf("Failed to read history: %v", err))
+		return
+	}
+	if len(commits) == 0 {
+		e.showMessage("No commit history for this file")
+		return
+	}
+
+	theme := e.themeManager.GetCurrentTheme()
+
+	list := tview.NewList().ShowSecondaryText(true)
+	list.SetMainTextColor(e.color(theme.GetForegroundColor()))
+	list.SetSelectedBackgroundColor(e.color(theme.GetBorderFocusColor()))
+	list.SetSelectedTextColor(e.color(theme.GetBackgroundColor()))
+	list.SetHighlightFullLine(true)
+
+	for i, c := range commits {
+		i := i
+		label := fmt.Sprintf("%s  %s", c.Hash[:8], c.When.Format("2006-01-02 15:04"))
+		list.AddItem(label, c.Message, 0, func() {
+			e.showRevisionDiff(root, relFile, commits, i)
+		})
+	}
+
+	list.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			e.closeFileHistory()
+			return nil
+		}
+		return event
+	})
+
+	modal := tview.NewFlex().SetDirection(tview.FlexRow).AddItem(list, 0, 1, true)
+	modal.SetBorder(true).SetTitle(fmt.Sprintf(" History: %s ", filepath.Base(leaf.File)))
+	modal.SetBackgroundColor(e.color(theme.GetBackgroundColor()))
+
+	e.pages.AddPage(historyPage, centeredModal(modal, 70, 20), true, true)
+	e.app.SetFocus(list)
+}
+
+// showRevisionDiff shows the diff between commits[index] and its parent
+// (or the empty tree, if it's the file's oldest known revision).
+func (e *Editor) showRevisionDiff(root, relFile string, commits []vcs.Commit, index int) {
+	revB := commits[index].Hash
+
+	var diffText string
+	if index+1 < len(commits) {
+		revA := commits[index+1].Hash
+		text, err := vcs.Diff(root, relFile, revA, revB)
+		if err != nil {
+			e.showError(fmt.Sprintf("Failed to diff revision: %v", err))
+			return
+		}
+		diffText = text
+	} else {
+		diffText = "(oldest known revision of this file)"
+	}
+
+	theme := e.themeManager.GetCurrentTheme()
+
+	view := tview.NewTextView().SetText(diffText).SetWrap(true)
+	view.SetBackgroundColor(e.color(theme.GetBackgroundColor()))
+	view.SetTextColor(e.color(theme.GetForegroundColor()))
+	view.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			e.pages.RemovePage(historyPage + "-diff")
+			e.app.SetFocus(e.activeTextArea())
+			return nil
+		}
+		return event
+	})
+
+	modal := tview.NewFlex().SetDirection(tview.FlexRow).AddItem(view, 0, 1, true)
+	modal.SetBorder(true).SetTitle(fmt.Sprintf(" %s ", commits[index].Hash[:8]))
+	modal.SetBackgroundColor(e.color(theme.GetBackgroundColor()))
+
+	e.pages.AddPage(historyPage+"-diff", centeredModal(modal, 90, 25), true, true)
+	e.app.SetFocus(view)
+}
+
+// closeFileHistory tears down the file history page and returns focus to
+// the text area.
+func (e *Editor) closeFileHistory() {
+	e.pages.RemovePage(historyPage)
+	e.app.SetFocus(e.activeTextArea())
+}
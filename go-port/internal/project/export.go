@@ -0,0 +1,202 @@
+package project
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+
+	"writers-cli/internal/syntax/fountain"
+)
+
+// ExportFormat identifies a screenplay export target Export supports.
+type ExportFormat string
+
+const (
+	// ExportFountainPDF renders an industry-standard screenplay PDF:
+	// Courier 12pt on US Letter, 1.5" left margin, centered character names.
+	ExportFountainPDF ExportFormat = "fountain-pdf"
+
+	// ExportFDX renders Final Draft XML (.fdx), the format most
+	// professional screenwriting software and script readers expect.
+	ExportFDX ExportFormat = "fdx"
+)
+
+// Export renders projectPath's .fountain screenplay into format, writing
+// the result alongside the source file (screenplay.pdf or screenplay.fdx)
+// and returning the path written.
+func Export(projectPath string, format ExportFormat) (string, error) {
+	source, sourcePath, err := readScreenplaySource(projectPath)
+	if err != nil {
+		return "", err
+	}
+
+	tokens := fountain.Tokenize(source)
+	base := strings.TrimSuffix(sourcePath, filepath.Ext(sourcePath))
+
+	switch format {
+	case ExportFountainPDF:
+		outPath := base + ".pdf"
+		return outPath, exportFountainPDF(outPath, tokens)
+	case ExportFDX:
+		outPath := base + ".fdx"
+		return outPath, exportFDX(outPath, tokens)
+	default:
+		return "", fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// readScreenplaySource finds the project's .fountain file and returns its
+// contents plus its path.
+func readScreenplaySource(projectPath string) (source, sourcePath string, err error) {
+	matches, err := filepath.Glob(filepath.Join(projectPath, "*.fountain"))
+	if err != nil {
+		return "", "", err
+	}
+	if len(matches) == 0 {
+		return "", "", fmt.Errorf("no .fountain file found in %s", projectPath)
+	}
+
+	data, err := os.ReadFile(matches[0])
+	if err != nil {
+		return "", "", err
+	}
+	return string(data), matches[0], nil
+}
+
+// Standard Hollywood screenplay page geometry, in inches from the page
+// edge. Character, parenthetical, and dialogue indents are measured from
+// the left edge of the page, not from the action/scene-heading margin.
+const (
+	pageMarginTop    = 1.0
+	pageMarginBottom = 1.0
+	pageMarginLeft   = 1.5
+	pageMarginRight  = 1.0
+
+	characterIndent     = 3.7
+	parentheticalIndent = 3.1
+	dialogueIndent      = 2.5
+	dialogueWidth       = 3.5
+
+	lineHeight = 1.0 / 6.0 // Courier 12pt is 6 lines per vertical inch
+)
+
+// exportFountainPDF renders tokens as a paginated screenplay PDF.
+func exportFountainPDF(outPath string, tokens []fountain.Token) error {
+	pdf := gofpdf.New("P", "in", "Letter", "")
+	pdf.SetMargins(pageMarginLeft, pageMarginTop, pageMarginRight)
+	pdf.SetAutoPageBreak(true, pageMarginBottom)
+	pdf.SetFont("Courier", "", 12)
+	pdf.AddPage()
+
+	pageWidth, _ := pdf.GetPageSize()
+	actionWidth := pageWidth - pageMarginRight - pageMarginLeft
+
+	for _, tok := range tokens {
+		text := strings.TrimSpace(tok.Text)
+		if text == "" {
+			continue
+		}
+
+		switch tok.Type {
+		case fountain.Note, fountain.Boneyard:
+			// Notes and cut material aren't part of the shooting draft.
+			continue
+		case fountain.SceneHeading:
+			pdf.Ln(lineHeight)
+			pdf.SetX(pageMarginLeft)
+			pdf.MultiCell(actionWidth, lineHeight, strings.ToUpper(text), "", "L", false)
+		case fountain.Transition:
+			pdf.SetX(pageMarginLeft)
+			pdf.CellFormat(actionWidth, lineHeight, strings.ToUpper(text), "", 1, "R", false, 0, "")
+		case fountain.Character:
+			pdf.SetX(characterIndent)
+			pdf.CellFormat(pageWidth-pageMarginRight-characterIndent, lineHeight, strings.ToUpper(text), "", 1, "L", false, 0, "")
+		case fountain.Parenthetical:
+			pdf.SetX(parentheticalIndent)
+			pdf.MultiCell(dialogueWidth, lineHeight, text, "", "L", false)
+		case fountain.Dialogue:
+			pdf.SetX(dialogueIndent)
+			pdf.MultiCell(dialogueWidth, lineHeight, text, "", "L", false)
+		default: // Action
+			pdf.SetX(pageMarginLeft)
+			pdf.MultiCell(actionWidth, lineHeight, text, "", "L", false)
+		}
+	}
+
+	return pdf.OutputFileAndClose(outPath)
+}
+
+// fdxParagraphTypes maps our Fountain token types to Final Draft's
+// Paragraph Type names. Notes and boneyard have no entry and are dropped,
+// matching fountain-pdf's treatment of cut/annotation-only text.
+var fdxParagraphTypes = map[fountain.TokenType]string{
+	fountain.SceneHeading:  "Scene Heading",
+	fountain.Action:        "Action",
+	fountain.Character:     "Character",
+	fountain.Parenthetical: "Parenthetical",
+	fountain.Dialogue:      "Dialogue",
+	fountain.Transition:    "Transition",
+}
+
+type fdxDocument struct {
+	XMLName      xml.Name   `xml:"FinalDraft"`
+	DocumentType string     `xml:"DocumentType,attr"`
+	Template     string     `xml:"Template,attr"`
+	Version      string     `xml:"Version,attr"`
+	Content      fdxContent `xml:"Content"`
+}
+
+type fdxContent struct {
+	Paragraphs []fdxParagraph `xml:"Paragraph"`
+}
+
+type fdxParagraph struct {
+	Type string `xml:"Type,attr"`
+	Text string `xml:"Text"`
+}
+
+// exportFDX renders tokens as Final Draft XML, merging consecutive tokens
+// of the same type into one paragraph the way a screenwriter would have
+// typed them.
+func exportFDX(outPath string, tokens []fountain.Token) error {
+	doc := fdxDocument{
+		DocumentType: "Script",
+		Template:     "No",
+		Version:      "1",
+	}
+
+	var current *fdxParagraph
+	for _, tok := range tokens {
+		paragraphType, ok := fdxParagraphTypes[tok.Type]
+		if !ok {
+			current = nil
+			continue
+		}
+
+		text := strings.TrimSpace(tok.Text)
+		if text == "" {
+			current = nil
+			continue
+		}
+
+		if current != nil && current.Type == paragraphType {
+			current.Text += " " + text
+			continue
+		}
+
+		doc.Content.Paragraphs = append(doc.Content.Paragraphs, fdxParagraph{Type: paragraphType, Text: text})
+		current = &doc.Content.Paragraphs[len(doc.Content.Paragraphs)-1]
+	}
+
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode FDX: %w", err)
+	}
+
+	data := append([]byte(xml.Header), out...)
+	return os.WriteFile(outPath, data, 0644)
+}
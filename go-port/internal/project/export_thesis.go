@@ -0,0 +1,75 @@
+package project
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+
+	"writers-cli/internal/references"
+)
+
+// ExportThesisPDF renders projectPath's thesis.md into outPath, resolving
+// "[@key]" citations against projectPath's references/ directory (see
+// references.LoadDir) and appending a compiled bibliography in style.
+func ExportThesisPDF(projectPath, outPath string, style references.Style) error {
+	source, err := os.ReadFile(filepath.Join(projectPath, "thesis.md"))
+	if err != nil {
+		return fmt.Errorf("failed to read thesis.md: %w", err)
+	}
+
+	refs, err := references.LoadDir(filepath.Join(projectPath, "references"))
+	if err != nil {
+		return fmt.Errorf("failed to load references: %w", err)
+	}
+
+	resolved, cited := references.ResolveCitations(string(source), refs, style)
+	if len(cited) > 0 {
+		resolved = strings.TrimRight(resolved, "\n") + "\n\n" + references.Bibliography(cited, style)
+	}
+
+	return renderMarkdownPDF(outPath, resolved)
+}
+
+// renderMarkdownPDF renders markdown as a simple paginated PDF: #/##/###
+// headings in bold at decreasing sizes, everything else as wrapped body
+// text. It doesn't attempt full Markdown fidelity (tables, inline
+// emphasis, links) - just enough structure for a readable draft export.
+func renderMarkdownPDF(outPath, markdown string) error {
+	pdf := gofpdf.New("P", "in", "Letter", "")
+	pdf.SetMargins(1, 1, 1)
+	pdf.SetAutoPageBreak(true, 1)
+	pdf.AddPage()
+	pdf.SetFont("Helvetica", "", 11)
+
+	pageWidth, _ := pdf.GetPageSize()
+	bodyWidth := pageWidth - 2
+
+	for _, line := range strings.Split(markdown, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			pdf.Ln(0.15)
+		case strings.HasPrefix(trimmed, "### "):
+			writeHeading(pdf, bodyWidth, 12, 0.22, strings.TrimPrefix(trimmed, "### "))
+		case strings.HasPrefix(trimmed, "## "):
+			writeHeading(pdf, bodyWidth, 14, 0.25, strings.TrimPrefix(trimmed, "## "))
+		case strings.HasPrefix(trimmed, "# "):
+			writeHeading(pdf, bodyWidth, 18, 0.3, strings.TrimPrefix(trimmed, "# "))
+		default:
+			pdf.SetFont("Helvetica", "", 11)
+			pdf.MultiCell(bodyWidth, 0.2, trimmed, "", "L", false)
+		}
+	}
+
+	return pdf.OutputFileAndClose(outPath)
+}
+
+func writeHeading(pdf *gofpdf.Fpdf, width float64, size float64, lineHeight float64, text string) {
+	pdf.SetFont("Helvetica", "B", size)
+	pdf.MultiCell(width, lineHeight, text, "", "L", false)
+	pdf.SetFont("Helvetica", "", 11)
+}
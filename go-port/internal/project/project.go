@@ -5,8 +5,18 @@ import (
 	"os"
 	"path/filepath"
 	"time"
+
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+
+	"writers-cli/internal/config"
+	"writers-cli/internal/vcs"
 )
 
+// ConfigFileName is the name of the project configuration file written to
+// the root of every writers-initialized project.
+const ConfigFileName = ".writers-project.yml"
+
 // Config holds project configuration
 type Config struct {
 	Name        string
@@ -31,8 +41,8 @@ type Project struct {
 
 // Goals holds project goals and targets
 type Goals struct {
-	DailyWords  int `yaml:"daily_words"`
-	TotalWords  int `yaml:"total_words"`
+	DailyWords  int    `yaml:"daily_words"`
+	TotalWords  int    `yaml:"total_words"`
 	Deadline    string `yaml:"deadline"`
 	Description string `yaml:"description"`
 }
@@ -44,34 +54,43 @@ type Settings struct {
 	WordWrap      bool   `yaml:"word_wrap"`
 	ShowWordCount bool   `yaml:"show_word_count"`
 	BackupEnabled bool   `yaml:"backup_enabled"`
+
+	// AutoCommit opts into committing the manuscript to git every time the
+	// editor saves a file, via vcs.Snapshot.
+	AutoCommit bool `yaml:"auto_commit"`
 }
 
-// Initialize creates a new writing project
-func Initialize(projectPath string, config *Config) error {
+// Initialize creates a new writing project on deps.Fs, scaffolding cfg.Type
+// from provider (see DefaultProvider for the usual choice).
+func Initialize(deps *config.Deps, projectPath string, cfg *Config, provider TemplateProvider) error {
 	// Create project directory if it doesn't exist
-	if err := os.MkdirAll(projectPath, 0755); err != nil {
+	if err := deps.Fs.MkdirAll(projectPath, 0755); err != nil {
 		return fmt.Errorf("failed to create project directory: %w", err)
 	}
 
+	def, ok := provider.Template(cfg.Type)
+	if !ok {
+		// Unknown type: fall back to the novel structure, same as before
+		// TemplateProvider existed.
+		def, _ = NewEmbeddedProvider().Template("novel")
+	}
+
 	// Create project structure based on type
-	if err := createProjectStructure(projectPath, config.Type); err != nil {
+	if err := scaffoldProject(deps, projectPath, cfg.Type, def); err != nil {
 		return fmt.Errorf("failed to create project structure: %w", err)
 	}
 
 	// Create project configuration file
+	now := deps.Clock.Now()
 	project := &Project{
-		Name:        config.Name,
-		Type:        config.Type,
-		Description: config.Description,
+		Name:        cfg.Name,
+		Type:        cfg.Type,
+		Description: cfg.Description,
 		Author:      getAuthorName(),
-		Created:     time.Now(),
-		Updated:     time.Now(),
+		Created:     now,
+		Updated:     now,
 		Version:     "1.0.0",
-		Goals: Goals{
-			DailyWords:  500,
-			TotalWords:  50000,
-			Description: "Complete first draft",
-		},
+		Goals:       def.Goals,
 		Settings: Settings{
 			Theme:         "dark",
 			AutoSave:      true,
@@ -81,13 +100,13 @@ func Initialize(projectPath string, config *Config) error {
 		},
 	}
 
-	if err := saveProjectConfig(projectPath, project); err != nil {
+	if err := saveProjectConfig(deps, projectPath, project); err != nil {
 		return fmt.Errorf("failed to save project config: %w", err)
 	}
 
 	// Initialize git repository if requested
-	if config.GitInit {
-		if err := initializeGit(projectPath); err != nil {
+	if cfg.GitInit {
+		if err := initializeGit(deps, projectPath); err != nil {
 			return fmt.Errorf("failed to initialize git: %w", err)
 		}
 	}
@@ -95,129 +114,41 @@ func Initialize(projectPath string, config *Config) error {
 	return nil
 }
 
-// createProjectStructure creates the directory structure for different project types
-func createProjectStructure(projectPath, projectType string) error {
-	structures := map[string][]string{
-		"novel": {
-			"chapters",
-			"characters",
-			"notes",
-			"research",
-			"drafts",
-			"outline",
-		},
-		"shortstories": {
-			"stories",
-			"drafts",
-			"published",
-			"submissions",
-			"notes",
-		},
-		"article": {
-			"drafts",
-			"research",
-			"images",
-			"notes",
-		},
-		"screenplay": {
-			"scenes",
-			"characters",
-			"treatments",
-			"notes",
-			"drafts",
-		},
-		"poetry": {
-			"poems",
-			"collections",
-			"drafts",
-			"notes",
-		},
-		"journal": {
-			"entries",
-			"templates",
-			"notes",
-		},
-		"academic": {
-			"chapters",
-			"references",
-			"research",
-			"notes",
-			"drafts",
-			"figures",
-		},
-	}
-
-	dirs, exists := structures[projectType]
-	if !exists {
-		dirs = structures["novel"] // default structure
-	}
-
-	// Create directories
-	for _, dir := range dirs {
+// scaffoldProject creates def's directories (each seeded with a generic
+// README) and seed files, plus the README.md/.gitignore every project type
+// gets regardless of def.
+func scaffoldProject(deps *config.Deps, projectPath, projectType string, def TemplateDef) error {
+	for _, dir := range def.Dirs {
 		dirPath := filepath.Join(projectPath, dir)
-		if err := os.MkdirAll(dirPath, 0755); err != nil {
+		if err := deps.Fs.MkdirAll(dirPath, 0755); err != nil {
 			return err
 		}
 
-		// Create a README in each directory
 		readmePath := filepath.Join(dirPath, "README.md")
 		readmeContent := fmt.Sprintf("# %s\n\nThis directory contains %s for your %s project.\n",
 			dir, dir, projectType)
 
-		if err := os.WriteFile(readmePath, []byte(readmeContent), 0644); err != nil {
+		if err := afero.WriteFile(deps.Fs, readmePath, []byte(readmeContent), 0644); err != nil {
 			return err
 		}
 	}
 
-	// Create main project files
-	if err := createProjectFiles(projectPath, projectType); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// createProjectFiles creates initial files for the project
-func createProjectFiles(projectPath, projectType string) error {
 	files := map[string]string{
-		"README.md": getReadmeTemplate(projectType),
+		"README.md":  getReadmeTemplate(projectType),
 		".gitignore": getGitignoreTemplate(),
 	}
-
-	// Add project-specific files
-	switch projectType {
-	case "novel":
-		files["outline/main-outline.md"] = getNovelOutlineTemplate()
-		files["characters/character-template.md"] = getCharacterTemplate()
-		files["chapters/chapter-01.md"] = getChapterTemplate()
-	case "shortstories":
-		files["stories/story-template.md"] = getStoryTemplate()
-		files["submissions/submission-tracker.md"] = getSubmissionTrackerTemplate()
-	case "article":
-		files["article.md"] = getArticleTemplate()
-	case "screenplay":
-		files["screenplay.fountain"] = getScreenplayTemplate()
-		files["characters/character-list.md"] = getCharacterTemplate()
-	case "poetry":
-		files["poems/first-poem.md"] = getPoemTemplate()
-		files["collections/collection-notes.md"] = getCollectionTemplate()
-	case "journal":
-		files["entries/template.md"] = getJournalTemplate()
-	case "academic":
-		files["thesis.md"] = getThesisTemplate()
-		files["references/bibliography.md"] = getBibliographyTemplate()
+	for path, content := range def.Files {
+		files[path] = content
 	}
 
-	// Create files
 	for filePath, content := range files {
 		fullPath := filepath.Join(projectPath, filePath)
 
-		// Create directory if it doesn't exist
-		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		if err := deps.Fs.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
 			return err
 		}
 
-		if err := os.WriteFile(fullPath, []byte(content), 0644); err != nil {
+		if err := afero.WriteFile(deps.Fs, fullPath, []byte(content), 0644); err != nil {
 			return err
 		}
 	}
@@ -226,59 +157,42 @@ func createProjectFiles(projectPath, projectType string) error {
 }
 
 // saveProjectConfig saves the project configuration
-func saveProjectConfig(projectPath string, project *Project) error {
-	// For now, create a simple YAML-like file
-	// In a full implementation, you'd use gopkg.in/yaml.v3
-	configPath := filepath.Join(projectPath, ".writers-project.yml")
-
-	content := fmt.Sprintf(`name: %s
-type: %s
-description: %s
-author: %s
-created: %s
-updated: %s
-version: %s
-
-goals:
-  daily_words: %d
-  total_words: %d
-  deadline: "%s"
-  description: "%s"
-
-settings:
-  theme: %s
-  auto_save: %t
-  word_wrap: %t
-  show_word_count: %t
-  backup_enabled: %t
-`,
-		project.Name,
-		project.Type,
-		project.Description,
-		project.Author,
-		project.Created.Format(time.RFC3339),
-		project.Updated.Format(time.RFC3339),
-		project.Version,
-		project.Goals.DailyWords,
-		project.Goals.TotalWords,
-		project.Goals.Deadline,
-		project.Goals.Description,
-		project.Settings.Theme,
-		project.Settings.AutoSave,
-		project.Settings.WordWrap,
-		project.Settings.ShowWordCount,
-		project.Settings.BackupEnabled,
-	)
-
-	return os.WriteFile(configPath, []byte(content), 0644)
+func saveProjectConfig(deps *config.Deps, projectPath string, project *Project) error {
+	configPath := filepath.Join(projectPath, ConfigFileName)
+
+	content, err := yaml.Marshal(project)
+	if err != nil {
+		return fmt.Errorf("failed to encode project config: %w", err)
+	}
+
+	return afero.WriteFile(deps.Fs, configPath, content, 0644)
+}
+
+// LoadConfig reads and parses projectPath's .writers-project.yml without
+// migrating or validating it - a best-effort read for callers that only
+// want to peek at a field and don't care about schema problems. Callers
+// opening a project for editing should use Load instead.
+func LoadConfig(deps *config.Deps, projectPath string) (*Project, error) {
+	configPath := filepath.Join(projectPath, ConfigFileName)
+
+	data, err := afero.ReadFile(deps.Fs, configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read project config: %w", err)
+	}
+
+	var project Project
+	if err := yaml.Unmarshal(data, &project); err != nil {
+		return nil, fmt.Errorf("failed to parse project config: %w", err)
+	}
+
+	return &project, nil
 }
 
-// initializeGit initializes a git repository
-func initializeGit(projectPath string) error {
-	// This is a simplified implementation
-	// In a real implementation, you'd use go-git or exec git commands
-	gitPath := filepath.Join(projectPath, ".git")
-	return os.MkdirAll(gitPath, 0755)
+// initializeGit initializes a real git repository at projectPath and
+// commits the project's scaffolded files as the initial commit. go-git
+// operates on the real filesystem directly, independently of deps.Fs.
+func initializeGit(deps *config.Deps, projectPath string) error {
+	return vcs.Init(projectPath, gitAuthor(), "Initial commit")
 }
 
 // getAuthorName gets the author name from environment or defaults
@@ -292,6 +206,21 @@ func getAuthorName() string {
 	return "Author"
 }
 
+// getAuthorEmail gets the commit author's email from the environment,
+// falling back to a placeholder address commits can still be made with.
+func getAuthorEmail() string {
+	if email := os.Getenv("GIT_AUTHOR_EMAIL"); email != "" {
+		return email
+	}
+	return "author@localhost"
+}
+
+// gitAuthor builds the vcs.Author commits made on behalf of the current
+// user are attributed to.
+func gitAuthor() vcs.Author {
+	return vcs.Author{Name: getAuthorName(), Email: getAuthorEmail()}
+}
+
 // Template functions
 func getReadmeTemplate(projectType string) string {
 	return fmt.Sprintf(`# %s Project
@@ -302,9 +231,9 @@ This is a %s project created with Writers CLI.
 
 Use the Writers CLI editor to begin writing:
 
-` + "```" + `bash
+`+"```"+`bash
 writers edit
-` + "```" + `
+`+"```"+`
 
 ## Project Structure
 
@@ -348,394 +277,3 @@ Thumbs.db
 *.mobi
 `
 }
-
-func getNovelOutlineTemplate() string {
-	return `# Novel Outline
-
-## Story Overview
-
-**Title:** Your Novel Title
-**Genre:**
-**Target Length:** 80,000 words
-**Logline:** One sentence summary of your story
-
-## Three-Act Structure
-
-### Act I - Setup (25%)
-- **Hook:**
-- **Inciting Incident:**
-- **Plot Point 1:**
-
-### Act II - Confrontation (50%)
-- **Rising Action:**
-- **Midpoint:**
-- **Plot Point 2:**
-
-### Act III - Resolution (25%)
-- **Climax:**
-- **Falling Action:**
-- **Resolution:**
-
-## Characters
-
-- **Protagonist:**
-- **Antagonist:**
-- **Supporting Characters:**
-
-## Themes
-
-- Primary theme:
-- Secondary themes:
-
-## Chapter Breakdown
-
-1. Chapter 1 -
-2. Chapter 2 -
-3. Chapter 3 -
-...
-`
-}
-
-func getCharacterTemplate() string {
-	return `# Character Profile
-
-## Basic Information
-
-**Name:**
-**Age:**
-**Occupation:**
-**Location:**
-
-## Physical Description
-
-**Appearance:**
-**Distinguishing Features:**
-
-## Personality
-
-**Traits:**
-**Strengths:**
-**Weaknesses:**
-**Fears:**
-**Goals:**
-
-## Background
-
-**Family:**
-**Education:**
-**Important Events:**
-
-## Role in Story
-
-**Function:**
-**Character Arc:**
-**Relationships:**
-
-## Voice and Dialogue
-
-**Speaking Style:**
-**Catchphrases:**
-**Internal Voice:**
-
-## Notes
-
-Additional character notes and development ideas.
-`
-}
-
-func getChapterTemplate() string {
-	return `# Chapter 1
-
-## Chapter Summary
-
-Brief summary of what happens in this chapter.
-
-## Goals
-
-- What needs to be accomplished
-- Character development
-- Plot advancement
-
----
-
-Start writing your chapter here...
-
-## Notes
-
-- Revision notes
-- Ideas for improvement
-- Continuity reminders
-`
-}
-
-func getStoryTemplate() string {
-	return `# Story Title
-
-**Author:** Your Name
-**Word Count:** 0 / Target: 5,000
-**Status:** Draft
-**Genre:**
-**Theme:**
-
-## Story Summary
-
-Brief summary of your story.
-
-## Notes
-
-- Character notes
-- Plot ideas
-- Setting details
-
----
-
-## Story
-
-Start your story here...
-`
-}
-
-func getSubmissionTrackerTemplate() string {
-	return `# Submission Tracker
-
-| Story | Market | Submitted | Response | Status | Notes |
-|-------|--------|-----------|----------|---------|-------|
-| Story 1 | Magazine A | 2024-01-01 | - | Pending | - |
-| Story 2 | Magazine B | 2024-01-15 | Rejection | Closed | Good feedback |
-
-## Markets to Consider
-
-- **Magazine A** - Sci-fi, pays $0.10/word, 5,000 word limit
-- **Magazine B** - Literary, pays $50, 3,000 word limit
-- **Magazine C** - Horror, pays $25, 2,500 word limit
-
-## Submission Guidelines
-
-- Always read guidelines carefully
-- Track response times
-- Keep records of feedback
-- Multiple submissions only if allowed
-`
-}
-
-func getArticleTemplate() string {
-	return `# Article Title
-
-**Author:** Your Name
-**Publication:** Target Publication
-**Word Count:** 0 / Target: 1,500
-**Status:** Draft
-**Deadline:**
-
-## Article Outline
-
-### Introduction
-- Hook
-- Background
-- Thesis
-
-### Main Points
-1. First point
-2. Second point
-3. Third point
-
-### Conclusion
-- Summary
-- Call to action
-
----
-
-## Article
-
-Start writing your article here...
-
-## Research Notes
-
-- Sources
-- Statistics
-- Quotes
-- References
-`
-}
-
-func getScreenplayTemplate() string {
-	return `Title: YOUR SCREENPLAY TITLE
-Author: Your Name
-Contact: your.email@example.com
-
-FADE IN:
-
-EXT. LOCATION - DAY
-
-Write your screenplay here using standard formatting.
-
-Character dialogue should be centered and formatted properly.
-
-FADE OUT.
-`
-}
-
-func getPoemTemplate() string {
-	return `# First Poem
-
-**Title:** Untitled
-**Form:** Free verse
-**Theme:**
-**Date:** Today
-
----
-
-Write your poem here.
-Each line can be its own line,
-or you can group them into stanzas.
-
-Let your creativity flow.
-
----
-
-## Notes
-
-- Inspiration
-- Revision ideas
-- Form experiments
-`
-}
-
-func getCollectionTemplate() string {
-	return `# Collection Notes
-
-**Collection Title:**
-**Theme:**
-**Target Length:** 20-30 poems
-**Status:** Planning
-
-## Poems to Include
-
-1. Poem 1 - Complete
-2. Poem 2 - Draft
-3. Poem 3 - Idea
-
-## Themes and Motifs
-
-- Primary theme:
-- Secondary themes:
-- Recurring images:
-
-## Structure and Flow
-
-- Opening poem:
-- Sections:
-- Closing poem:
-
-## Submission Plans
-
-- Journals to consider:
-- Contest deadlines:
-- Reading opportunities:
-`
-}
-
-func getJournalTemplate() string {
-	return `# Journal Entry - {{DATE}}
-
-## Mood
-
-**Overall feeling:**
-**Energy level:**
-
-## Today's Events
-
-What happened today that was significant?
-
-## Thoughts and Reflections
-
-What's on your mind?
-
-## Gratitude
-
-Three things you're grateful for:
-1.
-2.
-3.
-
-## Tomorrow's Goals
-
-What do you want to accomplish tomorrow?
-
----
-
-## Free Writing
-
-Just write whatever comes to mind...
-`
-}
-
-func getThesisTemplate() string {
-	return `# Thesis Title
-
-**Author:** Your Name
-**Department:**
-**Advisor:**
-**Target Length:** 100-200 pages
-**Defense Date:**
-
-## Abstract
-
-Brief summary of your research and findings.
-
-## Table of Contents
-
-1. Introduction
-2. Literature Review
-3. Methodology
-4. Results
-5. Discussion
-6. Conclusion
-7. References
-8. Appendices
-
----
-
-## Chapter 1: Introduction
-
-Start your thesis here...
-
-### Research Question
-
-What question are you trying to answer?
-
-### Hypothesis
-
-What do you expect to find?
-
-### Significance
-
-Why is this research important?
-`
-}
-
-func getBibliographyTemplate() string {
-	return `# Bibliography
-
-## Primary Sources
-
-- Author, A. (Year). *Title of work*. Publisher.
-- Author, B. (Year). Title of article. *Journal Name*, Volume(Issue), pages.
-
-## Secondary Sources
-
-- Author, C. (Year). *Title of book*. Publisher.
-- Author, D. (Year). Title of article. *Journal Name*, Volume(Issue), pages.
-
-## Online Sources
-
-- Author, E. (Year). Title of webpage. *Website Name*. URL
-
-## Guidelines
-
-- Follow your institution's citation style (APA, MLA, Chicago, etc.)
-- Keep track of all sources as you research
-- Include page numbers for direct quotes
-- Verify all URLs and access dates
-`
-}
@@ -0,0 +1,296 @@
+package project
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// scaffoldFS embeds the seed files for every built-in project type, so
+// adding a community template never requires patching the binary -
+// DirectoryProvider covers that case instead.
+//
+//go:embed all:scaffold
+var scaffoldFS embed.FS
+
+// TemplateDef describes everything Initialize needs to scaffold a project
+// type: the (initially empty, README-seeded) directories to create, the
+// seed files to write relative to the project root, and the default
+// writing goals for that type.
+type TemplateDef struct {
+	Dirs  []string
+	Files map[string]string
+	Goals Goals
+}
+
+// TemplateProvider resolves a project type name (e.g. "novel") into its
+// TemplateDef. Initialize accepts one so project types aren't limited to
+// what's compiled into the binary.
+type TemplateProvider interface {
+	// Template looks up name, returning ok=false if this provider doesn't
+	// know about it.
+	Template(name string) (TemplateDef, bool)
+
+	// Names lists every project type this provider can produce.
+	Names() []string
+}
+
+// defaultGoals are the writing goals every built-in project type starts
+// with; community templates set their own via template.yml.
+var defaultGoals = Goals{
+	DailyWords:  500,
+	TotalWords:  50000,
+	Description: "Complete first draft",
+}
+
+// embeddedDirs lists the directory structure for each built-in project
+// type. Seed file content lives under scaffold/<type>/ and is picked up
+// automatically by EmbeddedProvider.
+var embeddedDirs = map[string][]string{
+	"novel": {
+		"chapters",
+		"characters",
+		"notes",
+		"research",
+		"drafts",
+		"outline",
+	},
+	"shortstories": {
+		"stories",
+		"drafts",
+		"published",
+		"submissions",
+		"notes",
+	},
+	"article": {
+		"drafts",
+		"research",
+		"images",
+		"notes",
+	},
+	"screenplay": {
+		"scenes",
+		"characters",
+		"treatments",
+		"notes",
+		"drafts",
+	},
+	"poetry": {
+		"poems",
+		"collections",
+		"drafts",
+		"notes",
+	},
+	"journal": {
+		"entries",
+		"templates",
+		"notes",
+	},
+	"academic": {
+		"chapters",
+		"references",
+		"research",
+		"notes",
+		"drafts",
+		"figures",
+	},
+}
+
+// EmbeddedProvider serves the project types baked into the binary, with
+// seed file content read from scaffoldFS.
+type EmbeddedProvider struct{}
+
+// NewEmbeddedProvider returns a TemplateProvider for the built-in project
+// types (novel, shortstories, article, screenplay, poetry, journal,
+// academic).
+func NewEmbeddedProvider() *EmbeddedProvider {
+	return &EmbeddedProvider{}
+}
+
+// Template implements TemplateProvider.
+func (p *EmbeddedProvider) Template(name string) (TemplateDef, bool) {
+	dirs, ok := embeddedDirs[name]
+	if !ok {
+		return TemplateDef{}, false
+	}
+
+	files, err := embeddedSeedFiles(name)
+	if err != nil {
+		return TemplateDef{}, false
+	}
+
+	return TemplateDef{Dirs: dirs, Files: files, Goals: defaultGoals}, true
+}
+
+// Names implements TemplateProvider.
+func (p *EmbeddedProvider) Names() []string {
+	names := make([]string, 0, len(embeddedDirs))
+	for name := range embeddedDirs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// embeddedSeedFiles walks scaffold/<name> and returns its files keyed by
+// their path relative to the project root.
+func embeddedSeedFiles(name string) (map[string]string, error) {
+	root := filepath.Join("scaffold", name)
+
+	files := map[string]string{}
+	err := fs.WalkDir(scaffoldFS, root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		content, err := scaffoldFS.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(content)
+		return nil
+	})
+
+	return files, err
+}
+
+// templateManifestFile is the manifest DirectoryProvider reads from each
+// <dir>/<name>/template.yml.
+const templateManifestFile = "template.yml"
+
+// templateManifest is template.yml's shape: directories to create, seed
+// files (read from alongside the manifest), and default goals.
+type templateManifest struct {
+	Dirs  []string `yaml:"dirs"`
+	Files []string `yaml:"files"`
+	Goals Goals    `yaml:"goals"`
+}
+
+// DirectoryProvider serves project types installed under a directory of
+// per-template subdirectories, each holding a template.yml manifest plus
+// the seed files it lists - the layout `writers template install` writes
+// to and ~/.config/writers-cli/templates reads from.
+type DirectoryProvider struct {
+	Dir string
+}
+
+// NewDirectoryProvider returns a TemplateProvider reading templates from
+// dir, where each subdirectory is one named template.
+func NewDirectoryProvider(dir string) *DirectoryProvider {
+	return &DirectoryProvider{Dir: dir}
+}
+
+// Template implements TemplateProvider.
+func (p *DirectoryProvider) Template(name string) (TemplateDef, bool) {
+	manifestPath := filepath.Join(p.Dir, name, templateManifestFile)
+
+	data, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return TemplateDef{}, false
+	}
+
+	var manifest templateManifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return TemplateDef{}, false
+	}
+
+	files := make(map[string]string, len(manifest.Files))
+	for _, rel := range manifest.Files {
+		content, err := os.ReadFile(filepath.Join(p.Dir, name, rel))
+		if err != nil {
+			continue
+		}
+		files[rel] = string(content)
+	}
+
+	return TemplateDef{Dirs: manifest.Dirs, Files: files, Goals: manifest.Goals}, true
+}
+
+// Names implements TemplateProvider.
+func (p *DirectoryProvider) Names() []string {
+	entries, err := os.ReadDir(p.Dir)
+	if err != nil {
+		return nil
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(p.Dir, entry.Name(), templateManifestFile)); err != nil {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// ChainProvider tries each of its providers in order, so a directory of
+// user-installed templates can override (or add to) the built-ins.
+type ChainProvider []TemplateProvider
+
+// Template implements TemplateProvider.
+func (c ChainProvider) Template(name string) (TemplateDef, bool) {
+	for _, provider := range c {
+		if def, ok := provider.Template(name); ok {
+			return def, true
+		}
+	}
+	return TemplateDef{}, false
+}
+
+// Names implements TemplateProvider.
+func (c ChainProvider) Names() []string {
+	seen := map[string]bool{}
+	var names []string
+	for _, provider := range c {
+		for _, name := range provider.Names() {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// UserTemplatesDir returns ~/.config/writers-cli/templates, where
+// community templates installed via `writers template install` live.
+func UserTemplatesDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "writers-cli", "templates"), nil
+}
+
+// DefaultProvider chains UserTemplatesDir (so installed/custom templates
+// can override a built-in type, or add an entirely new one) over the
+// built-in EmbeddedProvider.
+func DefaultProvider() TemplateProvider {
+	chain := ChainProvider{}
+	if dir, err := UserTemplatesDir(); err == nil {
+		chain = append(chain, NewDirectoryProvider(dir))
+	}
+	return append(chain, NewEmbeddedProvider())
+}
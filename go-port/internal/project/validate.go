@@ -0,0 +1,169 @@
+package project
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+
+	"writers-cli/internal/config"
+)
+
+// CurrentVersion is the Version written to every project created by
+// Initialize, and the version migrations bring older projects up to.
+const CurrentVersion = "1.0.0"
+
+// migration upgrades a Project whose Version is from, returning the version
+// it becomes after applying. Add an entry here whenever Goals or Settings
+// gains a field that needs a default backfilled or a value reshaped.
+type migration struct {
+	from string
+	to   string
+	fn   func(*Project) error
+}
+
+// migrations is the chain Load walks to bring a loaded Project up to
+// CurrentVersion. It's empty today - no project schema change has shipped
+// yet - but Load already applies it on every load so future chunks can
+// append to it without touching the loading path again.
+var migrations []migration
+
+// applyMigrations repeatedly applies the migration matching project.Version
+// until none matches; a version no migration starts from is left as it is,
+// so only a complete chain ends at CurrentVersion. A project
+// with no Version (written before versioning existed) is treated as already
+// current, matching how Initialize has always stamped new projects.
+func applyMigrations(project *Project) error {
+	if project.Version == "" {
+		project.Version = CurrentVersion
+		return nil
+	}
+
+	for {
+		m, ok := migrationFrom(project.Version)
+		if !ok {
+			return nil
+		}
+		if err := m.fn(project); err != nil {
+			return fmt.Errorf("migrating project config from version %s: %w", m.from, err)
+		}
+		project.Version = m.to
+	}
+}
+
+func migrationFrom(version string) (migration, bool) {
+	for _, m := range migrations {
+		if m.from == version {
+			return m, true
+		}
+	}
+	return migration{}, false
+}
+
+// Load reads and parses projectPath's .writers-project.yml, migrates it to
+// CurrentVersion, and validates it, citing the offending line number from
+// the YAML source in any error. validThemes should be the caller's
+// registered theme names (e.g. themes.NewManager().ListThemeNames()); pass
+// nil to skip theme validation.
+//
+// Callers that only want a best-effort peek at an existing config - without
+// failing on a schema problem they don't care about - should use LoadConfig
+// instead.
+func Load(deps *config.Deps, projectPath string, validThemes []string) (*Project, error) {
+	configPath := filepath.Join(projectPath, ConfigFileName)
+
+	data, err := afero.ReadFile(deps.Fs, configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read project config: %w", err)
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("failed to parse project config: %w", err)
+	}
+
+	var proj Project
+	if err := yaml.Unmarshal(data, &proj); err != nil {
+		return nil, fmt.Errorf("failed to parse project config: %w", err)
+	}
+
+	if err := applyMigrations(&proj); err != nil {
+		return nil, err
+	}
+
+	if err := validate(&proj, &root, validThemes); err != nil {
+		return nil, fmt.Errorf("%s is invalid:\n%w", configPath, err)
+	}
+
+	return &proj, nil
+}
+
+// validate checks proj for schema problems that would silently break a
+// writing session - an unparsable deadline, a non-positive word goal, a
+// theme that isn't registered - citing the offending line in root, the
+// yaml.Node parse of the same source proj was unmarshaled from.
+func validate(proj *Project, root *yaml.Node, validThemes []string) error {
+	var errs []error
+
+	if proj.Goals.Deadline != "" {
+		if _, err := time.Parse(time.RFC3339, proj.Goals.Deadline); err != nil {
+			errs = append(errs, fmt.Errorf("line %d: goals.deadline %q is not a valid RFC3339 date",
+				findNodeLine(root, "goals", "deadline"), proj.Goals.Deadline))
+		}
+	}
+
+	if proj.Goals.DailyWords <= 0 {
+		errs = append(errs, fmt.Errorf("line %d: goals.daily_words must be positive, got %d",
+			findNodeLine(root, "goals", "daily_words"), proj.Goals.DailyWords))
+	}
+
+	if proj.Goals.TotalWords <= 0 {
+		errs = append(errs, fmt.Errorf("line %d: goals.total_words must be positive, got %d",
+			findNodeLine(root, "goals", "total_words"), proj.Goals.TotalWords))
+	}
+
+	if validThemes != nil && !slices.Contains(validThemes, proj.Settings.Theme) {
+		errs = append(errs, fmt.Errorf("line %d: settings.theme %q is not a registered theme",
+			findNodeLine(root, "settings", "theme"), proj.Settings.Theme))
+	}
+
+	return errors.Join(errs...)
+}
+
+// findNodeLine returns the source line of the mapping key at the end of
+// path (e.g. "goals", "deadline"), walking root - a parsed yaml.Node tree -
+// one key at a time. It returns 0 if path doesn't resolve to a mapping key,
+// which callers format the same as any other line number rather than
+// special-casing.
+func findNodeLine(root *yaml.Node, path ...string) int {
+	node := root
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	line := 0
+	for _, key := range path {
+		if node.Kind != yaml.MappingNode {
+			return 0
+		}
+
+		var value *yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				line = node.Content[i].Line
+				value = node.Content[i+1]
+				break
+			}
+		}
+		if value == nil {
+			return 0
+		}
+		node = value
+	}
+
+	return line
+}
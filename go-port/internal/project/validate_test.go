@@ -0,0 +1,201 @@
+package project
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+
+	"writers-cli/internal/config"
+)
+
+func testDeps(fs afero.Fs) *config.Deps {
+	return &config.Deps{Fs: fs}
+}
+
+func TestLoadValidProject(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	deps := testDeps(fs)
+
+	yamlSrc := `name: My Novel
+type: novel
+version: 1.0.0
+goals:
+  daily_words: 500
+  total_words: 80000
+settings:
+  theme: dark
+`
+	if err := afero.WriteFile(fs, "proj/"+ConfigFileName, []byte(yamlSrc), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+
+	proj, err := Load(deps, "proj", []string{"dark", "light"})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if proj.Name != "My Novel" {
+		t.Errorf("Name = %q, want %q", proj.Name, "My Novel")
+	}
+	if proj.Version != CurrentVersion {
+		t.Errorf("Version = %q, want %q", proj.Version, CurrentVersion)
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	deps := testDeps(fs)
+
+	if _, err := Load(deps, "nowhere", nil); err == nil {
+		t.Fatal("expected an error for a missing project config")
+	}
+}
+
+func TestLoadInvalidGoalsReportsLineNumbers(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	deps := testDeps(fs)
+
+	yamlSrc := `name: Bad Project
+version: 1.0.0
+goals:
+  daily_words: 0
+  total_words: -5
+settings:
+  theme: nonexistent
+`
+	if err := afero.WriteFile(fs, "proj/"+ConfigFileName, []byte(yamlSrc), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+
+	_, err := Load(deps, "proj", []string{"dark"})
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	msg := err.Error()
+	for _, want := range []string{"daily_words", "total_words", "settings.theme"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("error %q does not mention %q", msg, want)
+		}
+	}
+}
+
+func TestLoadSkipsThemeValidationWhenNilThemes(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	deps := testDeps(fs)
+
+	yamlSrc := `name: Project
+version: 1.0.0
+goals:
+  daily_words: 100
+  total_words: 1000
+settings:
+  theme: whatever-i-want
+`
+	if err := afero.WriteFile(fs, "proj/"+ConfigFileName, []byte(yamlSrc), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+
+	if _, err := Load(deps, "proj", nil); err != nil {
+		t.Fatalf("Load() with nil validThemes should skip theme check, got error: %v", err)
+	}
+}
+
+func TestApplyMigrationsNoVersionBecomesCurrent(t *testing.T) {
+	proj := &Project{}
+	if err := applyMigrations(proj); err != nil {
+		t.Fatalf("applyMigrations() error = %v", err)
+	}
+	if proj.Version != CurrentVersion {
+		t.Errorf("Version = %q, want %q", proj.Version, CurrentVersion)
+	}
+}
+
+func TestApplyMigrationsWalksChain(t *testing.T) {
+	original := migrations
+	t.Cleanup(func() { migrations = original })
+
+	migrations = []migration{
+		{from: "0.1.0", to: "0.2.0", fn: func(p *Project) error {
+			p.Goals.DailyWords = 250
+			return nil
+		}},
+		{from: "0.2.0", to: CurrentVersion, fn: func(p *Project) error {
+			p.Settings.Theme = "dark"
+			return nil
+		}},
+	}
+
+	proj := &Project{Version: "0.1.0"}
+	if err := applyMigrations(proj); err != nil {
+		t.Fatalf("applyMigrations() error = %v", err)
+	}
+	if proj.Version != CurrentVersion {
+		t.Errorf("Version = %q, want %q", proj.Version, CurrentVersion)
+	}
+	if proj.Goals.DailyWords != 250 {
+		t.Errorf("DailyWords = %d, want 250 (migration not applied)", proj.Goals.DailyWords)
+	}
+	if proj.Settings.Theme != "dark" {
+		t.Errorf("Theme = %q, want %q (migration not applied)", proj.Settings.Theme, "dark")
+	}
+}
+
+func TestApplyMigrationsStopsAtUnknownVersion(t *testing.T) {
+	original := migrations
+	t.Cleanup(func() { migrations = original })
+	migrations = nil
+
+	proj := &Project{Version: "9.9.9"}
+	if err := applyMigrations(proj); err != nil {
+		t.Fatalf("applyMigrations() error = %v", err)
+	}
+	if proj.Version != "9.9.9" {
+		t.Errorf("Version = %q, want unchanged %q", proj.Version, "9.9.9")
+	}
+}
+
+func TestApplyMigrationsPropagatesError(t *testing.T) {
+	original := migrations
+	t.Cleanup(func() { migrations = original })
+
+	wantErr := "boom"
+	migrations = []migration{
+		{from: "0.1.0", to: "0.2.0", fn: func(p *Project) error {
+			return errString(wantErr)
+		}},
+	}
+
+	proj := &Project{Version: "0.1.0"}
+	err := applyMigrations(proj)
+	if err == nil || !strings.Contains(err.Error(), wantErr) {
+		t.Fatalf("applyMigrations() error = %v, want one containing %q", err, wantErr)
+	}
+}
+
+type errString string
+
+func (e errString) Error() string { return string(e) }
+
+func TestFindNodeLine(t *testing.T) {
+	var root yaml.Node
+	src := `goals:
+  daily_words: 100
+  deadline: "2025-01-01T00:00:00Z"
+settings:
+  theme: dark
+`
+	if err := yaml.Unmarshal([]byte(src), &root); err != nil {
+		t.Fatalf("failed to parse fixture: %v", err)
+	}
+
+	if line := findNodeLine(&root, "goals", "daily_words"); line != 2 {
+		t.Errorf("findNodeLine(goals, daily_words) = %d, want 2", line)
+	}
+	if line := findNodeLine(&root, "settings", "theme"); line != 5 {
+		t.Errorf("findNodeLine(settings, theme) = %d, want 5", line)
+	}
+	if line := findNodeLine(&root, "nonexistent", "key"); line != 0 {
+		t.Errorf("findNodeLine(missing path) = %d, want 0", line)
+	}
+}
@@ -0,0 +1,178 @@
+package references
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseBibTeX parses BibTeX source into Reference records. It understands
+// the handful of fields Cite and InlineCite use (author, title, year,
+// journal/booktitle/publisher, volume, pages, doi, url) and ignores the
+// rest; string concatenation and @string macros aren't supported.
+func ParseBibTeX(data []byte) ([]Reference, error) {
+	src := string(data)
+	var refs []Reference
+
+	for i := 0; i < len(src); i++ {
+		if src[i] != '@' {
+			continue
+		}
+
+		entry, next, err := parseBibEntry(src, i)
+		if err != nil {
+			return nil, err
+		}
+		if entry != nil {
+			refs = append(refs, *entry)
+		}
+		i = next
+	}
+
+	return refs, nil
+}
+
+// parseBibEntry parses one "@type{key, field = {value}, ...}" entry
+// starting at src[start] == '@', returning the Reference and the index of
+// its closing brace.
+func parseBibEntry(src string, start int) (*Reference, int, error) {
+	i := start + 1
+
+	typeStart := i
+	for i < len(src) && src[i] != '{' {
+		i++
+	}
+	if i >= len(src) {
+		return nil, len(src) - 1, fmt.Errorf("unterminated entry at offset %d", start)
+	}
+	entryType := strings.ToLower(strings.TrimSpace(src[typeStart:i]))
+	i++ // skip '{'
+
+	keyStart := i
+	for i < len(src) && src[i] != ',' && src[i] != '}' {
+		i++
+	}
+	key := strings.TrimSpace(src[keyStart:i])
+
+	fields := map[string]string{}
+	for i < len(src) && src[i] != '}' {
+		if src[i] == ',' || isBibSpace(src[i]) {
+			i++
+			continue
+		}
+
+		nameStart := i
+		for i < len(src) && src[i] != '=' {
+			i++
+		}
+		if i >= len(src) {
+			return nil, len(src) - 1, fmt.Errorf("unterminated entry %q", key)
+		}
+		name := strings.ToLower(strings.TrimSpace(src[nameStart:i]))
+		i++ // skip '='
+
+		for i < len(src) && isBibSpace(src[i]) {
+			i++
+		}
+
+		value, next := parseBibValue(src, i)
+		i = next
+		fields[name] = strings.Join(strings.Fields(value), " ")
+	}
+
+	if i >= len(src) {
+		return nil, len(src) - 1, fmt.Errorf("unterminated entry %q", key)
+	}
+
+	ref := &Reference{
+		Key:       key,
+		Type:      entryType,
+		Authors:   parseBibAuthors(fields["author"]),
+		Title:     fields["title"],
+		Year:      fields["year"],
+		Container: firstNonEmpty(fields["journal"], fields["booktitle"], fields["publisher"]),
+		Volume:    fields["volume"],
+		Pages:     strings.ReplaceAll(fields["pages"], "--", "-"),
+		DOI:       fields["doi"],
+		URL:       fields["url"],
+	}
+	return ref, i, nil
+}
+
+// parseBibValue reads one field value starting at src[i]: a brace-balanced
+// {...} group, a quoted "..." string, or a bare token like a bibyear
+// number. It returns the value and the index just past it.
+func parseBibValue(src string, i int) (string, int) {
+	switch {
+	case i < len(src) && src[i] == '{':
+		depth := 0
+		start := i
+		for i < len(src) {
+			switch src[i] {
+			case '{':
+				depth++
+			case '}':
+				depth--
+				if depth == 0 {
+					i++
+					return src[start+1 : i-1], i
+				}
+			}
+			i++
+		}
+		return src[start+1:], i
+
+	case i < len(src) && src[i] == '"':
+		i++
+		start := i
+		for i < len(src) && src[i] != '"' {
+			i++
+		}
+		value := src[start:i]
+		if i < len(src) {
+			i++ // skip closing quote
+		}
+		return value, i
+
+	default:
+		start := i
+		for i < len(src) && src[i] != ',' && src[i] != '}' {
+			i++
+		}
+		return strings.TrimSpace(src[start:i]), i
+	}
+}
+
+func isBibSpace(b byte) bool {
+	return b == ' ' || b == '\n' || b == '\t' || b == '\r'
+}
+
+// parseBibAuthors splits a BibTeX "author" field (names joined by " and ",
+// each formatted "Family, Given") into Authors.
+func parseBibAuthors(field string) []Author {
+	if field == "" {
+		return nil
+	}
+
+	var authors []Author
+	for _, part := range strings.Split(field, " and ") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if family, given, ok := strings.Cut(part, ","); ok {
+			authors = append(authors, Author{Family: strings.TrimSpace(family), Given: strings.TrimSpace(given)})
+		} else {
+			authors = append(authors, Author{Family: part})
+		}
+	}
+	return authors
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
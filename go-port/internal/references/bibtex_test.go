@@ -0,0 +1,109 @@
+package references
+
+import "testing"
+
+func TestParseBibTeXArticle(t *testing.T) {
+	src := `@article{smith2020,
+  author = {Smith, John and Doe, Jane},
+  title = {A Study of Things},
+  journal = {Journal of Examples},
+  year = {2020},
+  volume = {12},
+  pages = {100--110},
+  doi = {10.1234/example}
+}`
+
+	refs, err := ParseBibTeX([]byte(src))
+	if err != nil {
+		t.Fatalf("ParseBibTeX() error = %v", err)
+	}
+	if len(refs) != 1 {
+		t.Fatalf("expected 1 reference, got %d", len(refs))
+	}
+
+	ref := refs[0]
+	if ref.Key != "smith2020" {
+		t.Errorf("Key = %q, want %q", ref.Key, "smith2020")
+	}
+	if ref.Type != "article" {
+		t.Errorf("Type = %q, want %q", ref.Type, "article")
+	}
+	if ref.Title != "A Study of Things" {
+		t.Errorf("Title = %q, want %q", ref.Title, "A Study of Things")
+	}
+	if ref.Container != "Journal of Examples" {
+		t.Errorf("Container = %q, want %q", ref.Container, "Journal of Examples")
+	}
+	if ref.Pages != "100-110" {
+		t.Errorf("Pages = %q, want %q (en-dash normalized)", ref.Pages, "100-110")
+	}
+	if len(ref.Authors) != 2 {
+		t.Fatalf("expected 2 authors, got %d", len(ref.Authors))
+	}
+	if ref.Authors[0].Family != "Smith" || ref.Authors[0].Given != "John" {
+		t.Errorf("Authors[0] = %+v, want Family=Smith Given=John", ref.Authors[0])
+	}
+	if ref.Authors[1].Family != "Doe" || ref.Authors[1].Given != "Jane" {
+		t.Errorf("Authors[1] = %+v, want Family=Doe Given=Jane", ref.Authors[1])
+	}
+}
+
+func TestParseBibTeXFallsBackToBookPublisher(t *testing.T) {
+	src := `@book{jones2019,
+  author = {Jones, Robert},
+  title = "The Long Way Home",
+  publisher = {Example Press},
+  year = 2019
+}`
+
+	refs, err := ParseBibTeX([]byte(src))
+	if err != nil {
+		t.Fatalf("ParseBibTeX() error = %v", err)
+	}
+	if len(refs) != 1 {
+		t.Fatalf("expected 1 reference, got %d", len(refs))
+	}
+	ref := refs[0]
+	if ref.Container != "Example Press" {
+		t.Errorf("Container = %q, want %q", ref.Container, "Example Press")
+	}
+	if ref.Year != "2019" {
+		t.Errorf("Year = %q, want %q", ref.Year, "2019")
+	}
+	if ref.Title != "The Long Way Home" {
+		t.Errorf("Title = %q, want %q", ref.Title, "The Long Way Home")
+	}
+}
+
+func TestParseBibTeXMultipleEntries(t *testing.T) {
+	src := `@article{a1, title = {First}, year = {2001}}
+@article{a2, title = {Second}, year = {2002}}`
+
+	refs, err := ParseBibTeX([]byte(src))
+	if err != nil {
+		t.Fatalf("ParseBibTeX() error = %v", err)
+	}
+	if len(refs) != 2 {
+		t.Fatalf("expected 2 references, got %d", len(refs))
+	}
+	if refs[0].Key != "a1" || refs[1].Key != "a2" {
+		t.Errorf("keys = %q, %q, want a1, a2", refs[0].Key, refs[1].Key)
+	}
+}
+
+func TestParseBibTeXNoAuthorsIsNil(t *testing.T) {
+	refs, err := ParseBibTeX([]byte(`@misc{x, title = {No Author}}`))
+	if err != nil {
+		t.Fatalf("ParseBibTeX() error = %v", err)
+	}
+	if refs[0].Authors != nil {
+		t.Errorf("Authors = %+v, want nil", refs[0].Authors)
+	}
+}
+
+func TestParseBibTeXUnterminatedEntryErrors(t *testing.T) {
+	_, err := ParseBibTeX([]byte(`@article{smith2020, title = {Unterminated`))
+	if err == nil {
+		t.Fatal("expected an error for unterminated entry")
+	}
+}
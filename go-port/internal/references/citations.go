@@ -0,0 +1,62 @@
+package references
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// citationPattern matches an inline citation like "[@smith2020]" in
+// markdown source - Pandoc's citation syntax, reused here rather than
+// inventing a new one.
+var citationPattern = regexp.MustCompile(`\[@([A-Za-z0-9_-]+)\]`)
+
+// ResolveCitations replaces every "[@key]" in doc with its in-text citation
+// in style, and returns the Reference records actually cited, in the order
+// they first appear, so the caller can append a matching bibliography
+// section. A key with no matching entry in refs is left unresolved in the
+// output and omitted from the returned slice.
+func ResolveCitations(doc string, refs map[string]Reference, style Style) (string, []Reference) {
+	var cited []Reference
+	seen := map[string]bool{}
+
+	resolved := citationPattern.ReplaceAllStringFunc(doc, func(match string) string {
+		key := citationPattern.FindStringSubmatch(match)[1]
+		ref, ok := refs[key]
+		if !ok {
+			return match
+		}
+		if !seen[key] {
+			seen[key] = true
+			cited = append(cited, ref)
+		}
+		return InlineCite(ref, style)
+	})
+
+	return resolved, cited
+}
+
+// Bibliography renders refs as a markdown "## References" section, sorted
+// by first author's family name (falling back to title), in style.
+func Bibliography(refs []Reference, style Style) string {
+	sorted := append([]Reference(nil), refs...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bibliographySortKey(sorted[i]) < bibliographySortKey(sorted[j])
+	})
+
+	var b strings.Builder
+	b.WriteString("## References\n\n")
+	for _, ref := range sorted {
+		fmt.Fprintf(&b, "%s\n\n", Cite(ref, style))
+	}
+
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
+
+func bibliographySortKey(ref Reference) string {
+	if len(ref.Authors) > 0 {
+		return strings.ToLower(ref.Authors[0].Family)
+	}
+	return strings.ToLower(ref.Title)
+}
@@ -0,0 +1,104 @@
+package references
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// crossrefResponse is the subset of CrossRef's GET /works/{doi} response
+// FetchByDOI needs.
+type crossrefResponse struct {
+	Message crossrefWork `json:"message"`
+}
+
+type crossrefWork struct {
+	Title          []string          `json:"title"`
+	Author         []crossrefAuthor  `json:"author"`
+	ContainerTitle []string          `json:"container-title"`
+	Volume         string            `json:"volume"`
+	Page           string            `json:"page"`
+	Type           string            `json:"type"`
+	DOI            string            `json:"DOI"`
+	URL            string            `json:"URL"`
+	Published      crossrefDateParts `json:"published"`
+}
+
+type crossrefAuthor struct {
+	Family string `json:"family"`
+	Given  string `json:"given"`
+}
+
+type crossrefDateParts struct {
+	DateParts [][]int `json:"date-parts"`
+}
+
+// FetchByDOI looks doi up against the CrossRef REST API and returns it as a
+// Reference, keyed by a citation key derived from the first author's
+// family name and publication year (e.g. "smith2020").
+func FetchByDOI(doi string) (Reference, error) {
+	resp, err := http.Get("https://api.crossref.org/works/" + doi)
+	if err != nil {
+		return Reference{}, fmt.Errorf("failed to reach CrossRef: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Reference{}, fmt.Errorf("CrossRef returned %s for %s", resp.Status, doi)
+	}
+
+	var out crossrefResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return Reference{}, fmt.Errorf("failed to parse CrossRef response: %w", err)
+	}
+
+	work := out.Message
+	authors := make([]Author, 0, len(work.Author))
+	for _, a := range work.Author {
+		authors = append(authors, Author{Family: a.Family, Given: a.Given})
+	}
+
+	title := ""
+	if len(work.Title) > 0 {
+		title = work.Title[0]
+	}
+	container := ""
+	if len(work.ContainerTitle) > 0 {
+		container = work.ContainerTitle[0]
+	}
+
+	year := ""
+	if len(work.Published.DateParts) > 0 && len(work.Published.DateParts[0]) > 0 {
+		year = strconv.Itoa(work.Published.DateParts[0][0])
+	}
+
+	ref := Reference{
+		Key:       citeKey(authors, year),
+		Type:      work.Type,
+		Authors:   authors,
+		Title:     title,
+		Year:      year,
+		Container: container,
+		Volume:    work.Volume,
+		Pages:     work.Page,
+		DOI:       strings.TrimPrefix(work.DOI, "https://doi.org/"),
+		URL:       work.URL,
+	}
+	if ref.DOI == "" {
+		ref.DOI = doi
+	}
+
+	return ref, nil
+}
+
+// citeKey derives a citation key like "smith2020" from an author list and
+// year, the same convention a hand-written .bib file would use.
+func citeKey(authors []Author, year string) string {
+	name := "ref"
+	if len(authors) > 0 {
+		name = strings.ToLower(strings.ReplaceAll(authors[0].Family, " ", ""))
+	}
+	return name + year
+}
@@ -0,0 +1,129 @@
+package references
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// cslItem mirrors the subset of CSL-JSON (Citation Style Language) fields
+// Reference understands; a real citation manager export typically includes
+// many more.
+type cslItem struct {
+	ID             string    `json:"id"`
+	Type           string    `json:"type"`
+	Title          string    `json:"title"`
+	ContainerTitle string    `json:"container-title"`
+	Volume         string    `json:"volume"`
+	Page           string    `json:"page"`
+	DOI            string    `json:"DOI"`
+	URL            string    `json:"URL"`
+	Author         []cslName `json:"author"`
+	Issued         *cslDate  `json:"issued,omitempty"`
+}
+
+type cslName struct {
+	Family string `json:"family"`
+	Given  string `json:"given"`
+}
+
+type cslDate struct {
+	DateParts [][]int `json:"date-parts"`
+}
+
+func (d *cslDate) year() string {
+	if d == nil || len(d.DateParts) == 0 || len(d.DateParts[0]) == 0 {
+		return ""
+	}
+	return strconv.Itoa(d.DateParts[0][0])
+}
+
+// ParseCSLJSON parses a CSL-JSON array - the format Zotero, Mendeley, and
+// CrossRef all export - into Reference records.
+func ParseCSLJSON(data []byte) ([]Reference, error) {
+	var items []cslItem
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("invalid CSL-JSON: %w", err)
+	}
+
+	refs := make([]Reference, 0, len(items))
+	for _, item := range items {
+		refs = append(refs, fromCSLItem(item))
+	}
+	return refs, nil
+}
+
+func fromCSLItem(item cslItem) Reference {
+	authors := make([]Author, 0, len(item.Author))
+	for _, a := range item.Author {
+		authors = append(authors, Author{Family: a.Family, Given: a.Given})
+	}
+
+	return Reference{
+		Key:       item.ID,
+		Type:      item.Type,
+		Authors:   authors,
+		Title:     item.Title,
+		Year:      item.Issued.year(),
+		Container: item.ContainerTitle,
+		Volume:    item.Volume,
+		Pages:     item.Page,
+		DOI:       item.DOI,
+		URL:       item.URL,
+	}
+}
+
+func toCSLItem(ref Reference) cslItem {
+	authors := make([]cslName, 0, len(ref.Authors))
+	for _, a := range ref.Authors {
+		authors = append(authors, cslName{Family: a.Family, Given: a.Given})
+	}
+
+	item := cslItem{
+		ID:             ref.Key,
+		Type:           ref.Type,
+		Title:          ref.Title,
+		ContainerTitle: ref.Container,
+		Volume:         ref.Volume,
+		Page:           ref.Pages,
+		DOI:            ref.DOI,
+		URL:            ref.URL,
+		Author:         authors,
+	}
+	if year, err := strconv.Atoi(ref.Year); err == nil {
+		item.Issued = &cslDate{DateParts: [][]int{{year}}}
+	}
+	return item
+}
+
+// AppendCSLJSON adds ref to the CSL-JSON array at path, creating the file
+// (and its parent directory) if it doesn't exist yet.
+func AppendCSLJSON(path string, ref Reference) error {
+	var items []cslItem
+
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, &items); err != nil {
+			return fmt.Errorf("failed to parse existing %s: %w", path, err)
+		}
+	case os.IsNotExist(err):
+		// Starting a new bibliography file.
+	default:
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	items = append(items, toCSLItem(ref))
+
+	out, err := json.MarshalIndent(items, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, out, 0644)
+}
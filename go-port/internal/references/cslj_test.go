@@ -0,0 +1,96 @@
+package references
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseCSLJSON(t *testing.T) {
+	src := `[
+		{
+			"id": "smith2020",
+			"type": "article-journal",
+			"title": "A Study of Things",
+			"container-title": "Journal of Examples",
+			"volume": "12",
+			"page": "100-110",
+			"DOI": "10.1234/example",
+			"URL": "https://example.com",
+			"author": [{"family": "Smith", "given": "John"}],
+			"issued": {"date-parts": [[2020, 3]]}
+		}
+	]`
+
+	refs, err := ParseCSLJSON([]byte(src))
+	if err != nil {
+		t.Fatalf("ParseCSLJSON() error = %v", err)
+	}
+	if len(refs) != 1 {
+		t.Fatalf("expected 1 reference, got %d", len(refs))
+	}
+
+	ref := refs[0]
+	if ref.Key != "smith2020" {
+		t.Errorf("Key = %q, want %q", ref.Key, "smith2020")
+	}
+	if ref.Year != "2020" {
+		t.Errorf("Year = %q, want %q", ref.Year, "2020")
+	}
+	if ref.Container != "Journal of Examples" {
+		t.Errorf("Container = %q, want %q", ref.Container, "Journal of Examples")
+	}
+	if len(ref.Authors) != 1 || ref.Authors[0].Family != "Smith" {
+		t.Errorf("Authors = %+v, want one author Family=Smith", ref.Authors)
+	}
+}
+
+func TestParseCSLJSONMissingIssuedDateHasNoYear(t *testing.T) {
+	refs, err := ParseCSLJSON([]byte(`[{"id": "x", "title": "No Date"}]`))
+	if err != nil {
+		t.Fatalf("ParseCSLJSON() error = %v", err)
+	}
+	if refs[0].Year != "" {
+		t.Errorf("Year = %q, want empty", refs[0].Year)
+	}
+}
+
+func TestParseCSLJSONInvalidJSON(t *testing.T) {
+	_, err := ParseCSLJSON([]byte(`not json`))
+	if err == nil {
+		t.Fatal("expected an error for invalid JSON")
+	}
+}
+
+func TestAppendCSLJSONCreatesAndAppends(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "nested", "refs.json")
+
+	first := Reference{Key: "a1", Title: "First", Year: "2001"}
+	if err := AppendCSLJSON(path, first); err != nil {
+		t.Fatalf("AppendCSLJSON() error = %v", err)
+	}
+
+	second := Reference{Key: "a2", Title: "Second", Year: "2002"}
+	if err := AppendCSLJSON(path, second); err != nil {
+		t.Fatalf("AppendCSLJSON() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", path, err)
+	}
+	refs, err := ParseCSLJSON(data)
+	if err != nil {
+		t.Fatalf("ParseCSLJSON() error = %v", err)
+	}
+	if len(refs) != 2 {
+		t.Fatalf("expected 2 references after two appends, got %d", len(refs))
+	}
+	if refs[0].Key != "a1" || refs[1].Key != "a2" {
+		t.Errorf("keys = %q, %q, want a1, a2", refs[0].Key, refs[1].Key)
+	}
+	if refs[0].Year != "2001" {
+		t.Errorf("Year = %q, want %q", refs[0].Year, "2001")
+	}
+}
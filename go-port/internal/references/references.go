@@ -0,0 +1,250 @@
+// Package references parses a project's bibliography - BibTeX (.bib) and
+// CSL-JSON files under its references/ directory - into typed Reference
+// records, and renders them as APA, MLA, or Chicago citations.
+package references
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Style identifies a citation style Cite and InlineCite can render.
+type Style string
+
+const (
+	APA     Style = "apa"
+	MLA     Style = "mla"
+	Chicago Style = "chicago"
+)
+
+// Author is one contributor to a Reference.
+type Author struct {
+	Family string
+	Given  string
+}
+
+// Reference is a single bibliography entry, normalized from whichever of
+// BibTeX or CSL-JSON it was parsed from.
+type Reference struct {
+	Key       string // citation key, e.g. "smith2020"
+	Type      string // "article-journal", "book", ...
+	Authors   []Author
+	Title     string
+	Year      string
+	Container string // journal or publisher
+	Volume    string
+	Pages     string
+	DOI       string
+	URL       string
+}
+
+// LoadDir parses every .bib and .json file under dir into a map of
+// Reference keyed by citation key, for resolving "[@key]" citations and
+// compiling a bibliography. A missing dir is reported as an empty map
+// rather than an error, since a project need not have one yet.
+func LoadDir(dir string) (map[string]Reference, error) {
+	refs := map[string]Reference{}
+
+	bibMatches, err := filepath.Glob(filepath.Join(dir, "*.bib"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range bibMatches {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		parsed, err := ParseBibTeX(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		for _, ref := range parsed {
+			refs[ref.Key] = ref
+		}
+	}
+
+	jsonMatches, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range jsonMatches {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		parsed, err := ParseCSLJSON(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		for _, ref := range parsed {
+			refs[ref.Key] = ref
+		}
+	}
+
+	return refs, nil
+}
+
+// Cite formats ref as a full bibliography entry in style.
+func Cite(ref Reference, style Style) string {
+	switch style {
+	case MLA:
+		return citeMLA(ref)
+	case Chicago:
+		return citeChicago(ref)
+	default:
+		return citeAPA(ref)
+	}
+}
+
+// InlineCite renders ref as a short in-text citation, e.g. "(Smith, 2020)",
+// for resolving a "[@key]" in the body of a document.
+func InlineCite(ref Reference, style Style) string {
+	name := "Unknown"
+	if len(ref.Authors) > 0 {
+		name = ref.Authors[0].Family
+		if len(ref.Authors) > 1 {
+			name += " et al."
+		}
+	}
+
+	if style == MLA {
+		// MLA in-text citations normally include a page number, which isn't
+		// available at the markdown-source level, so this renders the
+		// author-only form.
+		return fmt.Sprintf("(%s)", name)
+	}
+	if ref.Year != "" {
+		return fmt.Sprintf("(%s, %s)", name, ref.Year)
+	}
+	return fmt.Sprintf("(%s)", name)
+}
+
+func citeAPA(ref Reference) string {
+	var b strings.Builder
+
+	if a := authorsAPA(ref.Authors); a != "" {
+		b.WriteString(a)
+	}
+	if ref.Year != "" {
+		fmt.Fprintf(&b, " (%s).", ref.Year)
+	}
+	if ref.Title != "" {
+		fmt.Fprintf(&b, " %s.", ref.Title)
+	}
+	if ref.Container != "" {
+		fmt.Fprintf(&b, " %s", ref.Container)
+		if ref.Volume != "" {
+			fmt.Fprintf(&b, ", %s", ref.Volume)
+		}
+		if ref.Pages != "" {
+			fmt.Fprintf(&b, ", %s", ref.Pages)
+		}
+		b.WriteString(".")
+	}
+	if ref.DOI != "" {
+		fmt.Fprintf(&b, " https://doi.org/%s", ref.DOI)
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+func citeMLA(ref Reference) string {
+	var b strings.Builder
+
+	if a := authorsListed(ref.Authors); a != "" {
+		fmt.Fprintf(&b, "%s. ", a)
+	}
+	if ref.Title != "" {
+		fmt.Fprintf(&b, "\"%s.\" ", ref.Title)
+	}
+	if ref.Container != "" {
+		fmt.Fprintf(&b, "%s, ", ref.Container)
+	}
+	if ref.Volume != "" {
+		fmt.Fprintf(&b, "vol. %s, ", ref.Volume)
+	}
+	if ref.Year != "" {
+		fmt.Fprintf(&b, "%s, ", ref.Year)
+	}
+	if ref.Pages != "" {
+		fmt.Fprintf(&b, "pp. %s", ref.Pages)
+	}
+
+	return strings.TrimRight(strings.TrimSpace(b.String()), ",")
+}
+
+func citeChicago(ref Reference) string {
+	var b strings.Builder
+
+	if a := authorsListed(ref.Authors); a != "" {
+		fmt.Fprintf(&b, "%s. ", a)
+	}
+	if ref.Title != "" {
+		fmt.Fprintf(&b, "\"%s.\" ", ref.Title)
+	}
+	if ref.Container != "" {
+		fmt.Fprintf(&b, "%s", ref.Container)
+		if ref.Volume != "" {
+			fmt.Fprintf(&b, " %s", ref.Volume)
+		}
+		if ref.Year != "" {
+			fmt.Fprintf(&b, " (%s)", ref.Year)
+		}
+		if ref.Pages != "" {
+			fmt.Fprintf(&b, ": %s", ref.Pages)
+		}
+		b.WriteString(".")
+	} else if ref.Year != "" {
+		fmt.Fprintf(&b, "%s.", ref.Year)
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+// authorsAPA lists authors as "Family, F. M., & Family2, G.", APA's format.
+func authorsAPA(authors []Author) string {
+	parts := make([]string, 0, len(authors))
+	for _, a := range authors {
+		parts = append(parts, strings.TrimSpace(fmt.Sprintf("%s, %s", a.Family, initials(a.Given))))
+	}
+	return joinWithAmpersand(parts)
+}
+
+// authorsListed lists authors MLA/Chicago-style: "Family, Given" for the
+// first, "Given Family" for the rest, collapsing to "et al." past two.
+func authorsListed(authors []Author) string {
+	if len(authors) == 0 {
+		return ""
+	}
+	first := strings.TrimSpace(fmt.Sprintf("%s, %s", authors[0].Family, authors[0].Given))
+	switch {
+	case len(authors) == 1:
+		return first
+	case len(authors) == 2:
+		return fmt.Sprintf("%s, and %s %s", first, authors[1].Given, authors[1].Family)
+	default:
+		return first + ", et al."
+	}
+}
+
+func initials(given string) string {
+	fields := strings.Fields(given)
+	out := make([]string, 0, len(fields))
+	for _, f := range fields {
+		out = append(out, strings.ToUpper(f[:1])+".")
+	}
+	return strings.Join(out, " ")
+}
+
+func joinWithAmpersand(parts []string) string {
+	switch len(parts) {
+	case 0:
+		return ""
+	case 1:
+		return parts[0]
+	default:
+		return strings.Join(parts[:len(parts)-1], ", ") + ", & " + parts[len(parts)-1]
+	}
+}
@@ -0,0 +1,90 @@
+package submissions
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Market describes a publication's submission policies, used to flag
+// overdue submissions and sanity-check a story against its word limit.
+type Market struct {
+	Name                string
+	PayRateCentsPerWord int
+	WordLimit           int
+	ResponseSLA         time.Duration
+	AllowsSimultaneous  bool
+}
+
+// MarketDirectory maps a market's name to its Market record.
+type MarketDirectory map[string]Market
+
+// marketYAML is a Market's on-disk shape. ResponseSLA is a duration string
+// (e.g. "720h") rather than yaml.v3's native nanosecond encoding of
+// time.Duration, so the file stays human-writable.
+type marketYAML struct {
+	Name                string `yaml:"name"`
+	PayRateCentsPerWord int    `yaml:"pay_rate_cents_per_word"`
+	WordLimit           int    `yaml:"word_limit"`
+	ResponseSLA         string `yaml:"response_sla"`
+	AllowsSimultaneous  bool   `yaml:"allows_simultaneous"`
+}
+
+// DefaultMarketDirectoryPath returns ~/.config/writers-cli/markets.yml, the
+// shared market directory every project's submissions are checked against.
+func DefaultMarketDirectoryPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "writers-cli", "markets.yml"), nil
+}
+
+// LoadMarketDirectory reads a MarketDirectory from a YAML file listing
+// markets, e.g.:
+//
+//   - name: Clarkesworld
+//     pay_rate_cents_per_word: 10
+//     word_limit: 8000
+//     response_sla: 720h
+//     allows_simultaneous: false
+//
+// A missing file is reported as an empty directory rather than an error,
+// since "writers sub" is useful without one configured.
+func LoadMarketDirectory(path string) (MarketDirectory, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return MarketDirectory{}, nil
+		}
+		return nil, fmt.Errorf("failed to read market directory: %w", err)
+	}
+
+	var entries []marketYAML
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse market directory: %w", err)
+	}
+
+	dir := make(MarketDirectory, len(entries))
+	for _, entry := range entries {
+		market := Market{
+			Name:                entry.Name,
+			PayRateCentsPerWord: entry.PayRateCentsPerWord,
+			WordLimit:           entry.WordLimit,
+			AllowsSimultaneous:  entry.AllowsSimultaneous,
+		}
+		if entry.ResponseSLA != "" {
+			sla, err := time.ParseDuration(entry.ResponseSLA)
+			if err != nil {
+				return nil, fmt.Errorf("market %s: invalid response_sla %q: %w", entry.Name, entry.ResponseSLA, err)
+			}
+			market.ResponseSLA = sla
+		}
+		dir[entry.Name] = market
+	}
+
+	return dir, nil
+}
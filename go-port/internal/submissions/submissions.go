@@ -0,0 +1,178 @@
+// Package submissions tracks a project's story submissions to markets -
+// where a story was sent, when, and how it was received - so "writers sub"
+// can answer "what's still out?" and "what's overdue?" without a
+// spreadsheet, replacing the static markdown table the shortstories
+// template used to seed.
+package submissions
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+
+	"writers-cli/internal/config"
+)
+
+// Status is a submission's place in a market's response pipeline.
+type Status string
+
+const (
+	StatusSubmitted Status = "submitted"
+	StatusAccepted  Status = "accepted"
+	StatusRejected  Status = "rejected"
+	StatusWithdrawn Status = "withdrawn"
+)
+
+// Submission is one story sent to one market.
+type Submission struct {
+	Story        string     `json:"story"`
+	Market       string     `json:"market"`
+	SubmittedAt  time.Time  `json:"submitted_at"`
+	RespondedAt  *time.Time `json:"responded_at,omitempty"`
+	Status       Status     `json:"status"`
+	PaymentCents int        `json:"payment_cents"`
+	Notes        string     `json:"notes,omitempty"`
+}
+
+// Overdue reports whether s is still awaiting a response past market's
+// typical response time. A submission that has already been responded to,
+// or a market with no known SLA, is never overdue.
+func (s Submission) Overdue(market Market, now time.Time) bool {
+	if s.Status != StatusSubmitted || market.ResponseSLA <= 0 {
+		return false
+	}
+	return now.Sub(s.SubmittedAt) > market.ResponseSLA
+}
+
+const submissionsFile = "submissions.jsonl"
+
+// Path returns the path to projectPath's submission log.
+func Path(projectPath string) string {
+	return filepath.Join(projectPath, ".writers", submissionsFile)
+}
+
+// Add appends sub to projectPath's submission log.
+func Add(deps *config.Deps, projectPath string, sub Submission) error {
+	path := Path(projectPath)
+	if err := deps.Fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create .writers directory: %w", err)
+	}
+
+	f, err := deps.Fs.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open submission log: %w", err)
+	}
+	defer f.Close()
+
+	data, err := json.Marshal(sub)
+	if err != nil {
+		return fmt.Errorf("failed to encode submission: %w", err)
+	}
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write submission: %w", err)
+	}
+	return nil
+}
+
+// List loads every submission recorded for projectPath, in the order they
+// were added. A project with no submissions yet returns an empty slice, not
+// an error.
+func List(deps *config.Deps, projectPath string) ([]Submission, error) {
+	data, err := afero.ReadFile(deps.Fs, Path(projectPath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read submission log: %w", err)
+	}
+
+	var subs []Submission
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		var sub Submission
+		if err := json.Unmarshal([]byte(line), &sub); err != nil {
+			return nil, fmt.Errorf("failed to parse submission log: %w", err)
+		}
+		subs = append(subs, sub)
+	}
+
+	return subs, nil
+}
+
+// Update applies mutate to the index'th submission (0-based, in List order)
+// and rewrites the submission log with the result.
+func Update(deps *config.Deps, projectPath string, index int, mutate func(*Submission)) (Submission, error) {
+	subs, err := List(deps, projectPath)
+	if err != nil {
+		return Submission{}, err
+	}
+	if index < 0 || index >= len(subs) {
+		return Submission{}, fmt.Errorf("no submission at index %d", index)
+	}
+
+	mutate(&subs[index])
+
+	if err := rewrite(deps, projectPath, subs); err != nil {
+		return Submission{}, err
+	}
+	return subs[index], nil
+}
+
+// rewrite replaces projectPath's submission log with subs.
+func rewrite(deps *config.Deps, projectPath string, subs []Submission) error {
+	path := Path(projectPath)
+	if err := deps.Fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create .writers directory: %w", err)
+	}
+
+	var buf strings.Builder
+	for _, sub := range subs {
+		data, err := json.Marshal(sub)
+		if err != nil {
+			return fmt.Errorf("failed to encode submission: %w", err)
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+
+	return afero.WriteFile(deps.Fs, path, []byte(buf.String()), 0644)
+}
+
+// Stats summarizes a project's submissions by status.
+type Stats struct {
+	Total             int
+	Submitted         int
+	Accepted          int
+	Rejected          int
+	Withdrawn         int
+	TotalPaymentCents int
+}
+
+// Summarize computes Stats over subs.
+func Summarize(subs []Submission) Stats {
+	var s Stats
+	s.Total = len(subs)
+	for _, sub := range subs {
+		switch sub.Status {
+		case StatusSubmitted:
+			s.Submitted++
+		case StatusAccepted:
+			s.Accepted++
+			s.TotalPaymentCents += sub.PaymentCents
+		case StatusRejected:
+			s.Rejected++
+		case StatusWithdrawn:
+			s.Withdrawn++
+		}
+	}
+	return s
+}
@@ -0,0 +1,158 @@
+// Package fountain tokenizes Fountain-format screenplay text into its
+// structural elements (scene headings, action, character cues,
+// parentheticals, dialogue, transitions, notes, and boneyard), so the
+// editor can give a .fountain file proper screenplay visual hierarchy
+// instead of generic markdown syntax colors. See https://fountain.io/syntax
+// for the format this follows.
+package fountain
+
+import "strings"
+
+// TokenType identifies which Fountain element a line belongs to.
+type TokenType string
+
+const (
+	SceneHeading  TokenType = "scene_heading"
+	Action        TokenType = "action"
+	Character     TokenType = "character"
+	Parenthetical TokenType = "parenthetical"
+	Dialogue      TokenType = "dialogue"
+	Transition    TokenType = "transition"
+	Note          TokenType = "note"
+	Boneyard      TokenType = "boneyard"
+)
+
+// Token is one classified line of a Fountain document. Line is 1-based.
+type Token struct {
+	Type TokenType
+	Text string
+	Line int
+}
+
+// sceneHeadingPrefixes are the standard slugline prefixes Fountain
+// recognizes, matched case-insensitively.
+var sceneHeadingPrefixes = []string{"INT.", "EXT.", "INT/EXT.", "I/E.", "EST."}
+
+// Tokenize classifies every line of source by Fountain element type. It is
+// line-oriented, matching how the editor applies syntax colors elsewhere in
+// this codebase - a boneyard that spans multiple lines colors every line it
+// touches, rather than just the exact character span. A note is recognized
+// only when a whole line is one "[[...]]"; a note split across lines is not.
+func Tokenize(source string) []Token {
+	tokens := make([]Token, 0, strings.Count(source, "\n")+1)
+	lines := strings.Split(source, "\n")
+
+	inDialogue := false
+	inBoneyard := false
+	for i, raw := range lines {
+		lineNo := i + 1
+		line := strings.TrimRight(raw, "\r")
+		trimmed := strings.TrimSpace(line)
+
+		if inBoneyard {
+			tokens = append(tokens, Token{Boneyard, line, lineNo})
+			if strings.Contains(trimmed, "*/") {
+				inBoneyard = false
+			}
+			continue
+		}
+
+		if trimmed == "" {
+			inDialogue = false
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(trimmed, "/*"):
+			tokens = append(tokens, Token{Boneyard, line, lineNo})
+			if !strings.Contains(trimmed[2:], "*/") {
+				inBoneyard = true
+			}
+			inDialogue = false
+		case isNote(trimmed):
+			tokens = append(tokens, Token{Note, line, lineNo})
+		case isSceneHeading(trimmed):
+			tokens = append(tokens, Token{SceneHeading, line, lineNo})
+			inDialogue = false
+		case isTransition(trimmed):
+			tokens = append(tokens, Token{Transition, line, lineNo})
+			inDialogue = false
+		case inDialogue && isParenthetical(trimmed):
+			tokens = append(tokens, Token{Parenthetical, line, lineNo})
+		case isCharacterCue(lines, i):
+			tokens = append(tokens, Token{Character, line, lineNo})
+			inDialogue = true
+		case inDialogue:
+			tokens = append(tokens, Token{Dialogue, line, lineNo})
+		default:
+			tokens = append(tokens, Token{Action, line, lineNo})
+		}
+	}
+
+	return tokens
+}
+
+// isSceneHeading reports whether line is a slugline: one of the standard
+// INT./EXT. prefixes (case-insensitive), or a forced heading starting with
+// a single "." (not "..." ellipsis).
+func isSceneHeading(line string) bool {
+	upper := strings.ToUpper(line)
+	for _, prefix := range sceneHeadingPrefixes {
+		if strings.HasPrefix(upper, prefix) {
+			return true
+		}
+	}
+	return strings.HasPrefix(line, ".") && !strings.HasPrefix(line, "...")
+}
+
+// isTransition reports whether line is a transition: all-caps text ending
+// in "TO:" (CUT TO:, SMASH TO:), or forced with a leading ">".
+func isTransition(line string) bool {
+	if strings.HasPrefix(line, ">") && !strings.HasSuffix(line, "<") {
+		return true
+	}
+	return isAllCaps(line) && strings.HasSuffix(line, "TO:")
+}
+
+// isParenthetical reports whether line is a wrysly/parenthetical beneath a
+// character cue, such as "(quietly)".
+func isParenthetical(line string) bool {
+	return strings.HasPrefix(line, "(") && strings.HasSuffix(line, ")")
+}
+
+// isNote reports whether line is entirely a "[[note]]".
+func isNote(line string) bool {
+	return strings.HasPrefix(line, "[[") && strings.HasSuffix(line, "]]")
+}
+
+// isCharacterCue reports whether lines[i] is a character cue: all-caps
+// text, preceded by a blank line (or the top of the document), and
+// followed immediately by more text (the dialogue it introduces).
+func isCharacterCue(lines []string, i int) bool {
+	line := strings.TrimSpace(lines[i])
+	if !isAllCaps(line) {
+		return false
+	}
+	if i > 0 && strings.TrimSpace(lines[i-1]) != "" {
+		return false
+	}
+	if i+1 >= len(lines) || strings.TrimSpace(lines[i+1]) == "" {
+		return false
+	}
+	return true
+}
+
+// isAllCaps reports whether line has no lowercase letters and contains at
+// least one letter.
+func isAllCaps(line string) bool {
+	hasLetter := false
+	for _, r := range line {
+		if r >= 'a' && r <= 'z' {
+			return false
+		}
+		if r >= 'A' && r <= 'Z' {
+			hasLetter = true
+		}
+	}
+	return hasLetter
+}
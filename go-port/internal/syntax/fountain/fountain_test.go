@@ -0,0 +1,95 @@
+package fountain
+
+import "testing"
+
+func typesOf(tokens []Token) []TokenType {
+	types := make([]TokenType, len(tokens))
+	for i, tok := range tokens {
+		types[i] = tok.Type
+	}
+	return types
+}
+
+func assertTypes(t *testing.T, source string, want []TokenType) {
+	t.Helper()
+	got := typesOf(Tokenize(source))
+	if len(got) != len(want) {
+		t.Fatalf("Tokenize(%q) produced %v, want %v", source, got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Tokenize(%q)[%d] = %s, want %s", source, i, got[i], want[i])
+		}
+	}
+}
+
+func TestTokenizeSceneHeading(t *testing.T) {
+	assertTypes(t, "INT. HOUSE - DAY", []TokenType{SceneHeading})
+	assertTypes(t, "EXT. PARK - NIGHT", []TokenType{SceneHeading})
+	assertTypes(t, ".FLASHBACK", []TokenType{SceneHeading})
+}
+
+func TestTokenizeForcedSceneHeadingNotEllipsis(t *testing.T) {
+	assertTypes(t, "...and so it begins", []TokenType{Action})
+}
+
+func TestTokenizeTransition(t *testing.T) {
+	assertTypes(t, "CUT TO:", []TokenType{Transition})
+	assertTypes(t, ">FORCED TRANSITION", []TokenType{Transition})
+}
+
+func TestTokenizeCharacterAndDialogue(t *testing.T) {
+	source := "MARY\nI can't believe it."
+	assertTypes(t, source, []TokenType{Character, Dialogue})
+}
+
+func TestTokenizeParenthetical(t *testing.T) {
+	source := "MARY\n(quietly)\nI can't believe it."
+	assertTypes(t, source, []TokenType{Character, Parenthetical, Dialogue})
+}
+
+func TestTokenizeCharacterCueRequiresBlankLineBefore(t *testing.T) {
+	source := "She looked up.\nMARY\nI can't believe it."
+	assertTypes(t, source, []TokenType{Action, Action, Action})
+}
+
+func TestTokenizeBlankLineEndsDialogue(t *testing.T) {
+	source := "MARY\nI can't believe it.\n\nShe walked away."
+	got := Tokenize(source)
+	if len(got) != 3 {
+		t.Fatalf("expected blank line to be dropped, got %d tokens: %+v", len(got), got)
+	}
+	if got[2].Type != Action {
+		t.Fatalf("expected line after blank to reset to Action, got %s", got[2].Type)
+	}
+}
+
+func TestTokenizeNote(t *testing.T) {
+	assertTypes(t, "[[remember to fix this]]", []TokenType{Note})
+}
+
+func TestTokenizeSingleLineBoneyard(t *testing.T) {
+	assertTypes(t, "/* cut for pacing */", []TokenType{Boneyard})
+}
+
+func TestTokenizeMultiLineBoneyard(t *testing.T) {
+	source := "/* cut this scene\nit drags\n*/"
+	assertTypes(t, source, []TokenType{Boneyard, Boneyard, Boneyard})
+}
+
+func TestTokenizeAction(t *testing.T) {
+	assertTypes(t, "John walks into the room.", []TokenType{Action})
+}
+
+func TestTokenizeLineNumbersAreOneBased(t *testing.T) {
+	tokens := Tokenize("INT. HOUSE - DAY\n\nJohn enters.")
+	if len(tokens) != 2 {
+		t.Fatalf("expected 2 tokens, got %d", len(tokens))
+	}
+	if tokens[0].Line != 1 {
+		t.Fatalf("first token Line = %d, want 1", tokens[0].Line)
+	}
+	if tokens[1].Line != 3 {
+		t.Fatalf("second token Line = %d, want 3", tokens[1].Line)
+	}
+}
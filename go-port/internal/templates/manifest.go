@@ -0,0 +1,193 @@
+package templates
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestFileName is the manifest a template bundle may include to
+// describe which files to materialize and what variables they expect.
+const ManifestFileName = "writers-template.yaml"
+
+// Manifest describes a template bundle's files, prompts, and variables.
+type Manifest struct {
+	Name      string            `yaml:"name"`
+	Files     []string          `yaml:"files"`
+	Prompts   []Prompt          `yaml:"prompts"`
+	Variables map[string]string `yaml:"variables"`
+}
+
+// Prompt is a single value a template asks the user for on `writers init`.
+type Prompt struct {
+	Key     string `yaml:"key"`
+	Message string `yaml:"message"`
+	Default string `yaml:"default"`
+}
+
+// LoadManifest reads and validates writers-template.yaml from srcDir. A
+// missing manifest is not an error: srcDir's files are materialized as-is.
+func LoadManifest(srcDir string) (*Manifest, error) {
+	path := filepath.Join(srcDir, ManifestFileName)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", ManifestFileName, err)
+	}
+
+	var manifest Manifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", ManifestFileName, err)
+	}
+
+	for _, file := range manifest.Files {
+		if strings.HasPrefix(file, "/") || strings.Contains(file, "..") {
+			return nil, fmt.Errorf("%s: file entry %q escapes the template directory", ManifestFileName, file)
+		}
+	}
+
+	return &manifest, nil
+}
+
+// ResolveVariables merges a manifest's own Variables defaults with overrides
+// supplied by the caller (e.g. "writers init --var key=value"), then fills in
+// any remaining Prompt that the caller didn't override from its Default.
+// writers init/new have no interactive prompt of their own, so a Prompt left
+// without either an override or a Default is an error instead of silently
+// leaving the placeholder in the materialized files.
+func (m *Manifest) ResolveVariables(overrides map[string]string) (map[string]string, error) {
+	vars := make(map[string]string, len(m.Variables)+len(m.Prompts))
+	for k, v := range m.Variables {
+		vars[k] = v
+	}
+	for k, v := range overrides {
+		vars[k] = v
+	}
+
+	for _, prompt := range m.Prompts {
+		if _, ok := vars[prompt.Key]; ok {
+			continue
+		}
+		if prompt.Default != "" {
+			vars[prompt.Key] = prompt.Default
+			continue
+		}
+		return nil, fmt.Errorf("template requires --var %s=<value> (%s)", prompt.Key, prompt.Message)
+	}
+
+	return vars, nil
+}
+
+// Materialize copies a resolved template's files into destDir. If src is a
+// single file (e.g. an http:// template or a "new" single-file template),
+// its content is copied verbatim to destDir. If src is a directory with a
+// manifest, only the manifest's Files are copied; otherwise every file
+// except the manifest and .git are copied. Every copied file has any
+// "{{key}}" placeholder in vars replaced with its value; pass a nil vars to
+// copy files verbatim, e.g. when installing a template bundle for later use
+// rather than materializing it into a project.
+func Materialize(src, destDir string, force bool, vars map[string]string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return fmt.Errorf("failed to stat template %s: %w", src, err)
+	}
+
+	if !info.IsDir() {
+		return copyFile(src, filepath.Join(destDir, filepath.Base(src)), force, vars)
+	}
+
+	manifest, err := LoadManifest(src)
+	if err != nil {
+		return err
+	}
+
+	if manifest != nil && len(manifest.Files) > 0 {
+		for _, rel := range manifest.Files {
+			if err := copyFile(filepath.Join(src, rel), filepath.Join(destDir, rel), force, vars); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return filepath.Walk(src, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		if rel == ManifestFileName || strings.HasPrefix(rel, ".git"+string(filepath.Separator)) || rel == ".git" {
+			if fi.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		destPath := filepath.Join(destDir, rel)
+		if fi.IsDir() {
+			return os.MkdirAll(destPath, 0755)
+		}
+		return copyFile(path, destPath, force, vars)
+	})
+}
+
+// copyFile copies src to dest, substituting any "{{key}}" placeholder found
+// in vars along the way. When vars is empty the file is streamed through
+// unmodified, so binary template assets are never read into memory just to
+// find there was nothing to replace.
+func copyFile(src, dest string, force bool, vars map[string]string) error {
+	if !force {
+		if _, err := os.Stat(dest); err == nil {
+			return fmt.Errorf("%s already exists, use --force to overwrite", dest)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return err
+	}
+
+	if len(vars) == 0 {
+		in, err := os.Open(src)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", src, err)
+		}
+		defer in.Close()
+
+		out, err := os.Create(dest)
+		if err != nil {
+			return fmt.Errorf("failed to write %s: %w", dest, err)
+		}
+		defer out.Close()
+
+		_, err = io.Copy(out, in)
+		return err
+	}
+
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", src, err)
+	}
+	return os.WriteFile(dest, substituteVariables(data, vars), 0644)
+}
+
+// substituteVariables replaces every "{{key}}" in data with vars[key].
+func substituteVariables(data []byte, vars map[string]string) []byte {
+	pairs := make([]string, 0, len(vars)*2)
+	for k, v := range vars {
+		pairs = append(pairs, "{{"+k+"}}", v)
+	}
+	return []byte(strings.NewReplacer(pairs...).Replace(string(data)))
+}
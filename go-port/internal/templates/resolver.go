@@ -0,0 +1,210 @@
+// Package templates resolves project/file templates from the local
+// filesystem or from remote git hosts, so template sharing doesn't require
+// shipping every genre in the writers-cli binary.
+package templates
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Kind identifies where a template source comes from.
+type Kind int
+
+const (
+	KindLocal Kind = iota
+	KindGit
+	KindHTTP
+)
+
+// Source describes a parsed --template argument.
+type Source struct {
+	Kind Kind
+	Raw  string
+
+	// KindGit fields
+	Host   string
+	Owner  string
+	Repo   string
+	Subdir string
+	Ref    string
+
+	// KindHTTP / KindLocal fields
+	Path string // local filesystem path, or the raw URL for KindHTTP
+}
+
+var gitHostPattern = regexp.MustCompile(`^(github\.com|gitlab\.com)/([^/@]+)/([^/@]+)(/[^@]+)?(@.+)?$`)
+
+// Parse classifies a --template argument into a Source, without touching
+// the network or filesystem.
+func Parse(templateArg string) (Source, error) {
+	switch {
+	case strings.HasPrefix(templateArg, "https://") || strings.HasPrefix(templateArg, "http://"):
+		return Source{Kind: KindHTTP, Raw: templateArg, Path: templateArg}, nil
+
+	case gitHostPattern.MatchString(templateArg):
+		m := gitHostPattern.FindStringSubmatch(templateArg)
+		ref := "HEAD"
+		if m[5] != "" {
+			ref = strings.TrimPrefix(m[5], "@")
+		}
+		return Source{
+			Kind:   KindGit,
+			Raw:    templateArg,
+			Host:   m[1],
+			Owner:  m[2],
+			Repo:   m[3],
+			Subdir: strings.TrimPrefix(m[4], "/"),
+			Ref:    ref,
+		}, nil
+
+	case strings.HasPrefix(templateArg, "./") || strings.HasPrefix(templateArg, "../") ||
+		strings.HasPrefix(templateArg, "~/") || filepath.IsAbs(templateArg):
+		path, err := expandLocalPath(templateArg)
+		if err != nil {
+			return Source{}, err
+		}
+		return Source{Kind: KindLocal, Raw: templateArg, Path: path}, nil
+
+	default:
+		// Not a URL/host/path form - caller should fall back to a built-in
+		// template key (e.g. getTemplateContent).
+		return Source{}, fmt.Errorf("%q is not a resolvable template reference", templateArg)
+	}
+}
+
+// IsResolvable reports whether templateArg is a URL, git host reference, or
+// local path the resolver understands, as opposed to a built-in template
+// key like "novel-chapter".
+func IsResolvable(templateArg string) bool {
+	_, err := Parse(templateArg)
+	return err == nil
+}
+
+func expandLocalPath(path string) (string, error) {
+	if strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to expand ~: %w", err)
+		}
+		path = filepath.Join(home, strings.TrimPrefix(path, "~/"))
+	}
+	return filepath.Abs(path)
+}
+
+// CacheDir returns ~/.writers-cli/templates, creating it if necessary.
+func CacheDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(home, ".writers-cli", "templates")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// Resolve fetches (if necessary) and returns the local directory or file
+// holding the template content described by templateArg.
+func Resolve(templateArg string) (string, error) {
+	src, err := Parse(templateArg)
+	if err != nil {
+		return "", err
+	}
+
+	switch src.Kind {
+	case KindLocal:
+		if _, err := os.Stat(src.Path); err != nil {
+			return "", fmt.Errorf("template path %s does not exist: %w", src.Path, err)
+		}
+		return src.Path, nil
+
+	case KindGit:
+		return resolveGit(src)
+
+	case KindHTTP:
+		return resolveHTTP(src)
+	}
+
+	return "", fmt.Errorf("unsupported template kind")
+}
+
+// resolveGit shallow-clones the repo (or reuses an existing cache entry)
+// into ~/.writers-cli/templates/<host>/<owner>/<repo>@<ref>/ and returns the
+// (possibly subdir-qualified) path to the template content.
+func resolveGit(src Source) (string, error) {
+	cacheDir, err := CacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	dest := filepath.Join(cacheDir, src.Host, src.Owner, fmt.Sprintf("%s@%s", src.Repo, src.Ref))
+
+	if _, err := os.Stat(dest); os.IsNotExist(err) {
+		cloneURL := fmt.Sprintf("https://%s/%s/%s.git", src.Host, src.Owner, src.Repo)
+
+		args := []string{"clone", "--depth", "1"}
+		if src.Ref != "" && src.Ref != "HEAD" {
+			args = append(args, "--branch", src.Ref)
+		}
+		args = append(args, cloneURL, dest)
+
+		cmd := exec.Command("git", args...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return "", fmt.Errorf("failed to clone %s: %w\n%s", cloneURL, err, out)
+		}
+	}
+
+	if src.Subdir == "" {
+		return dest, nil
+	}
+	return filepath.Join(dest, src.Subdir), nil
+}
+
+// resolveHTTP downloads a raw template bundle or single markdown file into
+// the cache and returns the local path.
+func resolveHTTP(src Source) (string, error) {
+	cacheDir, err := CacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.Get(src.Path)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch %s: %w", src.Path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to fetch %s: status %s", src.Path, resp.Status)
+	}
+
+	name := filepath.Base(src.Path)
+	if name == "" || name == "/" {
+		name = "template.md"
+	}
+
+	dest := filepath.Join(cacheDir, "http", name)
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return "", err
+	}
+
+	f, err := os.Create(dest)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		return "", fmt.Errorf("failed to save %s: %w", src.Path, err)
+	}
+
+	return dest, nil
+}
@@ -139,25 +139,52 @@ func (t *DarkTheme) GetInfoColorCode() string {
 func (t *DarkTheme) GetSyntaxColors() SyntaxColors {
 	return SyntaxColors{
 		// Markdown specific colors
-		Heading:   tcell.NewHexColor(0x9cdcfe), // Light blue for headings
-		Emphasis:  tcell.NewHexColor(0xce9178), // Orange for italic text
-		Strong:    tcell.NewHexColor(0xdcdcaa), // Yellow for bold text
-		Link:      tcell.NewHexColor(0x4fc1ff), // Bright blue for links
-		Code:      tcell.NewHexColor(0xd7ba7d), // Golden for inline code
-		CodeBlock: tcell.NewHexColor(0xce9178), // Orange for code blocks
-		Quote:     tcell.NewHexColor(0x6a9955), // Green for blockquotes
-		List:      tcell.NewHexColor(0xc586c0), // Purple for list markers
+		Heading:   StyledColor{Fg: tcell.NewHexColor(0x9cdcfe), Attrs: tcell.AttrBold},      // Light blue for headings
+		Emphasis:  StyledColor{Fg: tcell.NewHexColor(0xce9178), Attrs: tcell.AttrItalic},    // Orange for italic text
+		Strong:    StyledColor{Fg: tcell.NewHexColor(0xdcdcaa), Attrs: tcell.AttrBold},      // Yellow for bold text
+		Link:      StyledColor{Fg: tcell.NewHexColor(0x4fc1ff), Attrs: tcell.AttrUnderline}, // Bright blue for links
+		Code:      StyledColor{Fg: tcell.NewHexColor(0xd7ba7d)},                             // Golden for inline code
+		CodeBlock: StyledColor{Fg: tcell.NewHexColor(0xce9178)},                             // Orange for code blocks
+		Quote:     StyledColor{Fg: tcell.NewHexColor(0x6a9955), Attrs: tcell.AttrItalic},    // Green for blockquotes
+		List:      StyledColor{Fg: tcell.NewHexColor(0xc586c0)},                             // Purple for list markers
 
 		// General syntax colors
-		Keyword:     tcell.NewHexColor(0x569cd6), // Blue for keywords
-		String:      tcell.NewHexColor(0xce9178), // Orange for strings
-		Comment:     tcell.NewHexColor(0x6a9955), // Green for comments
-		Number:      tcell.NewHexColor(0xb5cea8), // Light green for numbers
-		Operator:    tcell.NewHexColor(0xd4d4d4), // White for operators
-		Punctuation: tcell.NewHexColor(0xd4d4d4), // White for punctuation
-		Variable:    tcell.NewHexColor(0x9cdcfe), // Light blue for variables
-		Function:    tcell.NewHexColor(0xdcdcaa), // Yellow for functions
-		Type:        tcell.NewHexColor(0x4ec9b0), // Teal for types
+		Keyword:     StyledColor{Fg: tcell.NewHexColor(0x569cd6), Attrs: tcell.AttrBold}, // Blue for keywords
+		String:      StyledColor{Fg: tcell.NewHexColor(0xce9178)},                        // Orange for strings
+		Comment:     StyledColor{Fg: tcell.NewHexColor(0x6a9955), Attrs: tcell.AttrDim},  // Green for comments
+		Number:      StyledColor{Fg: tcell.NewHexColor(0xb5cea8)},                        // Light green for numbers
+		Operator:    StyledColor{Fg: tcell.NewHexColor(0xd4d4d4)},                        // White for operators
+		Punctuation: StyledColor{Fg: tcell.NewHexColor(0xd4d4d4)},                        // White for punctuation
+		Variable:    StyledColor{Fg: tcell.NewHexColor(0x9cdcfe)},                        // Light blue for variables
+		Function:    StyledColor{Fg: tcell.NewHexColor(0xdcdcaa)},                        // Yellow for functions
+		Type:        StyledColor{Fg: tcell.NewHexColor(0x4ec9b0)},                        // Teal for types
+
+		// Fountain screenplay colors
+		SceneHeading:  StyledColor{Fg: tcell.NewHexColor(0x569cd6), Attrs: tcell.AttrBold},   // Blue for sluglines
+		Character:     StyledColor{Fg: tcell.NewHexColor(0xdcdcaa), Attrs: tcell.AttrBold},   // Yellow for character cues
+		Parenthetical: StyledColor{Fg: tcell.NewHexColor(0x6a9955), Attrs: tcell.AttrItalic}, // Green for wrylies
+		Dialogue:      StyledColor{Fg: tcell.NewHexColor(0xd4d4d4)},                          // White for dialogue
+		Transition:    StyledColor{Fg: tcell.NewHexColor(0xc586c0)},                          // Purple for transitions
+		Note:          StyledColor{Fg: tcell.NewHexColor(0x608b4e), Attrs: tcell.AttrItalic}, // Green for [[notes]]
+		Boneyard:      StyledColor{Fg: tcell.NewHexColor(0x6e7681), Attrs: tcell.AttrDim},    // Dimmed gray for /* cut */ text
+	}
+}
+
+// GetProseColors returns prose-specific styling tuned to the Dark+ palette.
+func (t *DarkTheme) GetProseColors() ProseColors {
+	return ProseColors{
+		DialogueQuote:      StyledColor{Fg: tcell.NewHexColor(0xce9178)},                          // Orange, matching quoted strings
+		Footnote:           StyledColor{Fg: tcell.NewHexColor(0x6a9955), Attrs: tcell.AttrItalic}, // Green, matching comments
+		CharacterName:      StyledColor{Fg: tcell.NewHexColor(0xdcdcaa), Attrs: tcell.AttrBold},   // Yellow, matching character cues
+		SceneHeading:       StyledColor{Fg: tcell.NewHexColor(0x569cd6), Attrs: tcell.AttrBold},   // Blue, matching sluglines
+		StageDirection:     StyledColor{Fg: tcell.NewHexColor(0x6a9955), Attrs: tcell.AttrItalic}, // Green, matching wrylies
+		WordCountMilestone: StyledColor{Fg: tcell.NewHexColor(0x4ec9b0), Attrs: tcell.AttrBold},   // Teal success color
+		SpellError:         StyledColor{Fg: tcell.NewHexColor(0xf44747), Attrs: tcell.AttrUnderline},
+		GrammarWarning:     StyledColor{Fg: tcell.NewHexColor(0xffcc02), Attrs: tcell.AttrUnderline},
+		ReadabilityEasy:    StyledColor{Fg: tcell.NewHexColor(0x4ec9b0)},
+		ReadabilityMedium:  StyledColor{Fg: tcell.NewHexColor(0xffcc02)},
+		ReadabilityHard:    StyledColor{Fg: tcell.NewHexColor(0xf44747)},
+		FocusedSentence:    StyledColor{Fg: tcell.NewHexColor(0xffffff), Attrs: tcell.AttrBold}, // Bright white under the typewriter
 	}
 }
 
@@ -0,0 +1,160 @@
+package themes
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// DraculaTheme implements the Dracula palette (https://draculatheme.com/).
+type DraculaTheme struct {
+	*BaseTheme
+}
+
+// NewDraculaTheme creates a new Dracula theme instance.
+func NewDraculaTheme() *DraculaTheme {
+	return &DraculaTheme{
+		BaseTheme: NewBaseTheme("dracula", "Dracula", true),
+	}
+}
+
+func (t *DraculaTheme) GetBackgroundColor() tcell.Color {
+	return tcell.NewHexColor(0x282a36) // background
+}
+
+func (t *DraculaTheme) GetForegroundColor() tcell.Color {
+	return tcell.NewHexColor(0xf8f8f2) // foreground
+}
+
+func (t *DraculaTheme) GetBorderColor() tcell.Color {
+	return tcell.NewHexColor(0x44475a) // current line
+}
+
+func (t *DraculaTheme) GetBorderFocusColor() tcell.Color {
+	return tcell.NewHexColor(0xbd93f9) // purple
+}
+
+func (t *DraculaTheme) GetTitleColor() tcell.Color {
+	return tcell.NewHexColor(0x8be9fd) // cyan
+}
+
+func (t *DraculaTheme) GetStatusBgColor() tcell.Color {
+	return tcell.NewHexColor(0x44475a) // current line
+}
+
+func (t *DraculaTheme) GetStatusFgColor() tcell.Color {
+	return tcell.NewHexColor(0xf8f8f2) // foreground
+}
+
+func (t *DraculaTheme) GetInfoBgColor() tcell.Color {
+	return tcell.NewHexColor(0x282a36) // background
+}
+
+func (t *DraculaTheme) GetInfoFgColor() tcell.Color {
+	return tcell.NewHexColor(0xf8f8f2) // foreground
+}
+
+func (t *DraculaTheme) GetHelpBgColor() tcell.Color {
+	return tcell.NewHexColor(0x44475a) // current line
+}
+
+func (t *DraculaTheme) GetHelpFgColor() tcell.Color {
+	return tcell.NewHexColor(0x8be9fd) // cyan
+}
+
+func (t *DraculaTheme) GetLineNumberBgColor() tcell.Color {
+	return tcell.NewHexColor(0x282a36) // background
+}
+
+func (t *DraculaTheme) GetLineNumberFgColor() tcell.Color {
+	return tcell.NewHexColor(0x6272a4) // comment
+}
+
+func (t *DraculaTheme) GetLineNumberActiveFgColor() tcell.Color {
+	return tcell.NewHexColor(0xf8f8f2) // foreground
+}
+
+func (t *DraculaTheme) GetSelectionBgColor() tcell.Color {
+	return tcell.NewHexColor(0x44475a) // current line
+}
+
+func (t *DraculaTheme) GetSelectionFgColor() tcell.Color {
+	return tcell.NewHexColor(0xf8f8f2) // foreground
+}
+
+func (t *DraculaTheme) GetCursorColor() tcell.Color {
+	return tcell.NewHexColor(0xf8f8f2) // foreground
+}
+
+func (t *DraculaTheme) GetCursorInsertColor() tcell.Color {
+	return tcell.NewHexColor(0xff79c6) // pink
+}
+
+func (t *DraculaTheme) GetSuccessColor() tcell.Color {
+	return tcell.NewHexColor(0x50fa7b) // green
+}
+
+func (t *DraculaTheme) GetWarningColor() tcell.Color {
+	return tcell.NewHexColor(0xf1fa8c) // yellow
+}
+
+func (t *DraculaTheme) GetErrorColor() tcell.Color {
+	return tcell.NewHexColor(0xff5555) // red
+}
+
+func (t *DraculaTheme) GetInfoColor() tcell.Color {
+	return tcell.NewHexColor(0x8be9fd) // cyan
+}
+
+func (t *DraculaTheme) GetDimmedColor() tcell.Color {
+	return tcell.NewHexColor(0x6272a4) // comment
+}
+
+func (t *DraculaTheme) GetErrorColorCode() string {
+	return "#ff5555"
+}
+
+func (t *DraculaTheme) GetSuccessColorCode() string {
+	return "#50fa7b"
+}
+
+func (t *DraculaTheme) GetWarningColorCode() string {
+	return "#f1fa8c"
+}
+
+func (t *DraculaTheme) GetInfoColorCode() string {
+	return "#8be9fd"
+}
+
+func (t *DraculaTheme) GetSyntaxColors() SyntaxColors {
+	return SyntaxColors{
+		Heading:   StyledColor{Fg: tcell.NewHexColor(0xbd93f9), Attrs: tcell.AttrBold},
+		Emphasis:  StyledColor{Fg: tcell.NewHexColor(0xf1fa8c), Attrs: tcell.AttrItalic},
+		Strong:    StyledColor{Fg: tcell.NewHexColor(0xff79c6), Attrs: tcell.AttrBold},
+		Link:      StyledColor{Fg: tcell.NewHexColor(0x8be9fd), Attrs: tcell.AttrUnderline},
+		Code:      StyledColor{Fg: tcell.NewHexColor(0x50fa7b)},
+		CodeBlock: StyledColor{Fg: tcell.NewHexColor(0xff5555)},
+		Quote:     StyledColor{Fg: tcell.NewHexColor(0x6272a4), Attrs: tcell.AttrItalic},
+		List:      StyledColor{Fg: tcell.NewHexColor(0xff79c6)},
+
+		Keyword:     StyledColor{Fg: tcell.NewHexColor(0xff79c6), Attrs: tcell.AttrBold},
+		String:      StyledColor{Fg: tcell.NewHexColor(0xf1fa8c)},
+		Comment:     StyledColor{Fg: tcell.NewHexColor(0x6272a4), Attrs: tcell.AttrDim},
+		Number:      StyledColor{Fg: tcell.NewHexColor(0xbd93f9)},
+		Operator:    StyledColor{Fg: tcell.NewHexColor(0xff79c6)},
+		Punctuation: StyledColor{Fg: tcell.NewHexColor(0xf8f8f2)},
+		Variable:    StyledColor{Fg: tcell.NewHexColor(0xf8f8f2)},
+		Function:    StyledColor{Fg: tcell.NewHexColor(0x50fa7b)},
+		Type:        StyledColor{Fg: tcell.NewHexColor(0x8be9fd)},
+
+		SceneHeading:  StyledColor{Fg: tcell.NewHexColor(0xbd93f9), Attrs: tcell.AttrBold},
+		Character:     StyledColor{Fg: tcell.NewHexColor(0xff79c6), Attrs: tcell.AttrBold},
+		Parenthetical: StyledColor{Fg: tcell.NewHexColor(0x6272a4), Attrs: tcell.AttrItalic},
+		Dialogue:      StyledColor{Fg: tcell.NewHexColor(0xf8f8f2)},
+		Transition:    StyledColor{Fg: tcell.NewHexColor(0x8be9fd)},
+		Note:          StyledColor{Fg: tcell.NewHexColor(0x50fa7b), Attrs: tcell.AttrItalic},
+		Boneyard:      StyledColor{Fg: tcell.NewHexColor(0x6272a4), Attrs: tcell.AttrDim},
+	}
+}
+
+func (t *DraculaTheme) GetWelcomeMessage() string {
+	return "🧛 Dracula Active - Happy Writing!"
+}
@@ -0,0 +1,444 @@
+package themes
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// FileTheme is a Theme loaded from a flat TOML, YAML, or JSON color file, such as
+// ~/.config/writers-cli/themes/solarized.toml. Anything the file doesn't
+// define falls back to BaseTheme's defaults.
+type FileTheme struct {
+	*BaseTheme
+	colors map[string]tcell.Color
+	syntax SyntaxColors
+}
+
+// LoadFromFile loads a theme from a TOML, YAML, or JSON file mapping color
+// keys to values - a "#rrggbb" hex code, a tcell named color (red), or a
+// bare 256-palette index (202) - mirroring the color maps micro and amfora
+// use for their colorscheme files. For example:
+//
+//	background = "#002b36"
+//	foreground = "#839496"
+//	border = "#586e75"
+//
+//	[syntax]
+//	heading = "#268bd2"
+//	emphasis = "#dc322f"
+//
+// The format is dispatched on the file's extension (.toml, .yaml, .yml, or
+// .json).
+// Recognized top-level keys are background, foreground, border,
+// borderFocus, title, statusBg, statusFg, infoBg, infoFg, helpBg, helpFg,
+// lineNumberBg, lineNumberFg, selectionBg, selectionFg, cursor,
+// cursorInsert, success, warning, error, info, and dimmed; a nested
+// "syntax" table (or "syntax.*" dotted keys) supplies SyntaxColors'
+// fields (heading, emphasis, strong, link, code, codeBlock, quote, list,
+// keyword, string, comment, number, operator, punctuation, variable,
+// function, type) plus Fountain screenplay element fields (sceneHeading,
+// character, parenthetical, dialogue, transition, note, boneyard). A
+// "syntax" field's value may add ':'-separated text attributes after its
+// color - "#268bd2:bold", "red:underline:reverse" - see parseStyleSpec.
+//
+// The theme is registered under the file's base name with the extension
+// stripped (solarized.toml -> "solarized").
+func LoadFromFile(path string) (Theme, error) {
+	raw, err := decodeThemeFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read theme %s: %w", path, err)
+	}
+
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if name == "" {
+		return nil, fmt.Errorf("theme file %s has no usable name", path)
+	}
+
+	flat := flattenThemeFile(raw, "")
+
+	colors := make(map[string]tcell.Color)
+	for key, value := range flat {
+		if strings.HasPrefix(key, "syntax.") {
+			continue
+		}
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if c, ok := resolveFileColor(str); ok {
+			colors[key] = c
+		}
+	}
+
+	theme := &FileTheme{
+		BaseTheme: NewBaseTheme(name, displayNameFor(name), isDarkFileTheme(colors)),
+		colors:    colors,
+		syntax:    buildFileSyntaxColors(flat),
+	}
+
+	return theme, nil
+}
+
+// decodeThemeFile reads path and unmarshals it into a nested string-keyed
+// map, choosing TOML, YAML, or JSON based on its extension.
+func decodeThemeFile(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := make(map[string]interface{})
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".toml":
+		err = toml.Unmarshal(data, &raw)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &raw)
+	case ".json":
+		err = json.Unmarshal(data, &raw)
+	default:
+		return nil, fmt.Errorf("unsupported theme file extension %q", ext)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// flattenThemeFile turns a nested map such as {"syntax": {"heading": ...}}
+// into dotted keys such as "syntax.heading", so both a "[syntax]" TOML
+// table and equivalent YAML nesting resolve the same way.
+func flattenThemeFile(node map[string]interface{}, prefix string) map[string]interface{} {
+	flat := make(map[string]interface{})
+	for key, value := range node {
+		full := key
+		if prefix != "" {
+			full = prefix + "." + key
+		}
+		if nested, ok := value.(map[string]interface{}); ok {
+			for k, v := range flattenThemeFile(nested, full) {
+				flat[k] = v
+			}
+			continue
+		}
+		flat[full] = value
+	}
+	return flat
+}
+
+// resolveFileColor resolves value as a "#rrggbb" hex code, one of tcell's
+// named colors (red), or a bare 256-palette index (202).
+func resolveFileColor(value string) (tcell.Color, bool) {
+	if index, err := strconv.Atoi(value); err == nil {
+		return tcell.PaletteColor(index), true
+	}
+
+	color := tcell.GetColor(value)
+	if color == tcell.ColorDefault && !strings.EqualFold(value, "default") {
+		return 0, false
+	}
+	return color, true
+}
+
+// styleAttrNames maps the attribute names fzf's extended --color spec uses
+// to tcell's attribute bits. "regular" is accepted as a no-op, matching fzf.
+var styleAttrNames = map[string]tcell.AttrMask{
+	"regular":   tcell.AttrNone,
+	"bold":      tcell.AttrBold,
+	"dim":       tcell.AttrDim,
+	"underline": tcell.AttrUnderline,
+	"italic":    tcell.AttrItalic,
+	"reverse":   tcell.AttrReverse,
+	"blink":     tcell.AttrBlink,
+}
+
+// parseStyleSpec parses a color value optionally followed by ':'-separated
+// attribute names - "#2b6cb0", "red:underline:reverse" - into a StyledColor,
+// mirroring the extended color spec style fzf's --color flag uses. The
+// color segment is resolved the same way a plain color value is, via
+// resolveFileColor.
+func parseStyleSpec(value string) (StyledColor, bool) {
+	parts := strings.Split(value, ":")
+
+	color, ok := resolveFileColor(parts[0])
+	if !ok {
+		return StyledColor{}, false
+	}
+
+	var attrs tcell.AttrMask
+	for _, part := range parts[1:] {
+		attr, ok := styleAttrNames[strings.ToLower(strings.TrimSpace(part))]
+		if !ok {
+			return StyledColor{}, false
+		}
+		attrs |= attr
+	}
+
+	return StyledColor{Fg: color, Attrs: attrs}, true
+}
+
+// isDarkFileTheme guesses whether a file theme is dark from the perceived
+// brightness of its background color, defaulting to dark if unset.
+func isDarkFileTheme(colors map[string]tcell.Color) bool {
+	bg, ok := colors["background"]
+	if !ok {
+		return true
+	}
+	r, g, b := bg.RGB()
+	luminance := (299*r + 587*g + 114*b) / 1000
+	return luminance < 128
+}
+
+// buildFileSyntaxColors starts from BaseTheme's default syntax colors and
+// overlays any "syntax.*" keys flat defines. A value may be a plain color
+// ("#2b6cb0", "red", "202") or a style spec adding ':'-separated attribute
+// names ("#2b6cb0:bold", "red:underline:reverse") - see parseStyleSpec.
+func buildFileSyntaxColors(flat map[string]interface{}) SyntaxColors {
+	base := (&BaseTheme{}).GetSyntaxColors()
+
+	assign := func(key string, dst *StyledColor) {
+		value, ok := flat["syntax."+key]
+		if !ok {
+			return
+		}
+		str, ok := value.(string)
+		if !ok {
+			return
+		}
+		if styled, ok := parseStyleSpec(str); ok {
+			*dst = styled
+		}
+	}
+
+	assign("heading", &base.Heading)
+	assign("emphasis", &base.Emphasis)
+	assign("strong", &base.Strong)
+	assign("link", &base.Link)
+	assign("code", &base.Code)
+	assign("codeBlock", &base.CodeBlock)
+	assign("quote", &base.Quote)
+	assign("list", &base.List)
+	assign("keyword", &base.Keyword)
+	assign("string", &base.String)
+	assign("comment", &base.Comment)
+	assign("number", &base.Number)
+	assign("operator", &base.Operator)
+	assign("punctuation", &base.Punctuation)
+	assign("variable", &base.Variable)
+	assign("function", &base.Function)
+	assign("type", &base.Type)
+
+	assign("sceneHeading", &base.SceneHeading)
+	assign("character", &base.Character)
+	assign("parenthetical", &base.Parenthetical)
+	assign("dialogue", &base.Dialogue)
+	assign("transition", &base.Transition)
+	assign("note", &base.Note)
+	assign("boneyard", &base.Boneyard)
+
+	return base
+}
+
+// color looks up key's resolved color, reporting false if the file never
+// set it.
+func (t *FileTheme) color(key string) (tcell.Color, bool) {
+	c, ok := t.colors[key]
+	return c, ok
+}
+
+func (t *FileTheme) GetBackgroundColor() tcell.Color {
+	if c, ok := t.color("background"); ok {
+		return c
+	}
+	return t.BaseTheme.GetBackgroundColor()
+}
+
+func (t *FileTheme) GetForegroundColor() tcell.Color {
+	if c, ok := t.color("foreground"); ok {
+		return c
+	}
+	return t.BaseTheme.GetForegroundColor()
+}
+
+func (t *FileTheme) GetBorderColor() tcell.Color {
+	if c, ok := t.color("border"); ok {
+		return c
+	}
+	return t.BaseTheme.GetBorderColor()
+}
+
+func (t *FileTheme) GetBorderFocusColor() tcell.Color {
+	if c, ok := t.color("borderFocus"); ok {
+		return c
+	}
+	return t.BaseTheme.GetBorderFocusColor()
+}
+
+func (t *FileTheme) GetTitleColor() tcell.Color {
+	if c, ok := t.color("title"); ok {
+		return c
+	}
+	return t.BaseTheme.GetTitleColor()
+}
+
+func (t *FileTheme) GetStatusBgColor() tcell.Color {
+	if c, ok := t.color("statusBg"); ok {
+		return c
+	}
+	return t.BaseTheme.GetStatusBgColor()
+}
+
+func (t *FileTheme) GetStatusFgColor() tcell.Color {
+	if c, ok := t.color("statusFg"); ok {
+		return c
+	}
+	return t.BaseTheme.GetStatusFgColor()
+}
+
+func (t *FileTheme) GetInfoBgColor() tcell.Color {
+	if c, ok := t.color("infoBg"); ok {
+		return c
+	}
+	return t.BaseTheme.GetInfoBgColor()
+}
+
+func (t *FileTheme) GetInfoFgColor() tcell.Color {
+	if c, ok := t.color("infoFg"); ok {
+		return c
+	}
+	return t.BaseTheme.GetInfoFgColor()
+}
+
+func (t *FileTheme) GetHelpBgColor() tcell.Color {
+	if c, ok := t.color("helpBg"); ok {
+		return c
+	}
+	return t.BaseTheme.GetHelpBgColor()
+}
+
+func (t *FileTheme) GetHelpFgColor() tcell.Color {
+	if c, ok := t.color("helpFg"); ok {
+		return c
+	}
+	return t.BaseTheme.GetHelpFgColor()
+}
+
+func (t *FileTheme) GetLineNumberBgColor() tcell.Color {
+	if c, ok := t.color("lineNumberBg"); ok {
+		return c
+	}
+	return t.BaseTheme.GetLineNumberBgColor()
+}
+
+func (t *FileTheme) GetLineNumberFgColor() tcell.Color {
+	if c, ok := t.color("lineNumberFg"); ok {
+		return c
+	}
+	return t.BaseTheme.GetLineNumberFgColor()
+}
+
+func (t *FileTheme) GetLineNumberActiveFgColor() tcell.Color {
+	return t.GetLineNumberFgColor()
+}
+
+func (t *FileTheme) GetSelectionBgColor() tcell.Color {
+	if c, ok := t.color("selectionBg"); ok {
+		return c
+	}
+	return t.BaseTheme.GetSelectionBgColor()
+}
+
+func (t *FileTheme) GetSelectionFgColor() tcell.Color {
+	if c, ok := t.color("selectionFg"); ok {
+		return c
+	}
+	return t.BaseTheme.GetSelectionFgColor()
+}
+
+func (t *FileTheme) GetCursorColor() tcell.Color {
+	if c, ok := t.color("cursor"); ok {
+		return c
+	}
+	return t.BaseTheme.GetCursorColor()
+}
+
+func (t *FileTheme) GetCursorInsertColor() tcell.Color {
+	if c, ok := t.color("cursorInsert"); ok {
+		return c
+	}
+	return t.BaseTheme.GetCursorInsertColor()
+}
+
+func (t *FileTheme) GetSuccessColor() tcell.Color {
+	if c, ok := t.color("success"); ok {
+		return c
+	}
+	return t.BaseTheme.GetSuccessColor()
+}
+
+func (t *FileTheme) GetSuccessColorCode() string {
+	if c, ok := t.color("success"); ok {
+		return hexCode(c)
+	}
+	return t.BaseTheme.GetSuccessColorCode()
+}
+
+func (t *FileTheme) GetWarningColor() tcell.Color {
+	if c, ok := t.color("warning"); ok {
+		return c
+	}
+	return t.BaseTheme.GetWarningColor()
+}
+
+func (t *FileTheme) GetWarningColorCode() string {
+	if c, ok := t.color("warning"); ok {
+		return hexCode(c)
+	}
+	return t.BaseTheme.GetWarningColorCode()
+}
+
+func (t *FileTheme) GetErrorColor() tcell.Color {
+	if c, ok := t.color("error"); ok {
+		return c
+	}
+	return t.BaseTheme.GetErrorColor()
+}
+
+func (t *FileTheme) GetErrorColorCode() string {
+	if c, ok := t.color("error"); ok {
+		return hexCode(c)
+	}
+	return t.BaseTheme.GetErrorColorCode()
+}
+
+func (t *FileTheme) GetInfoColor() tcell.Color {
+	if c, ok := t.color("info"); ok {
+		return c
+	}
+	return t.BaseTheme.GetInfoColor()
+}
+
+func (t *FileTheme) GetInfoColorCode() string {
+	if c, ok := t.color("info"); ok {
+		return hexCode(c)
+	}
+	return t.BaseTheme.GetInfoColorCode()
+}
+
+func (t *FileTheme) GetDimmedColor() tcell.Color {
+	if c, ok := t.color("dimmed"); ok {
+		return c
+	}
+	return t.BaseTheme.GetDimmedColor()
+}
+
+func (t *FileTheme) GetSyntaxColors() SyntaxColors {
+	return t.syntax
+}
@@ -0,0 +1,56 @@
+package themes
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// groupStyle is one highlight group's resolved color/attributes, parsed
+// from an external editor's colorscheme file. It's the shared lookup value
+// behind LoadVimColorscheme and LoadMicroColorscheme, which differ only in
+// how they parse a file into a map of these.
+type groupStyle struct {
+	fg, bg       tcell.Color
+	hasFg, hasBg bool
+	attrs        tcell.AttrMask
+}
+
+// groupFg returns groups[name]'s foreground if set, else fallback.
+func groupFg(groups map[string]groupStyle, name string, fallback tcell.Color) tcell.Color {
+	if g, ok := groups[name]; ok && g.hasFg {
+		return g.fg
+	}
+	return fallback
+}
+
+// groupBg returns groups[name]'s background if set, else fallback.
+func groupBg(groups map[string]groupStyle, name string, fallback tcell.Color) tcell.Color {
+	if g, ok := groups[name]; ok && g.hasBg {
+		return g.bg
+	}
+	return fallback
+}
+
+// groupEither returns groups[name]'s background if set, else its
+// foreground, else fallback - for single-color roles (like GetCursorColor)
+// where a highlight group conventionally defines only one of the two.
+func groupEither(groups map[string]groupStyle, name string, fallback tcell.Color) tcell.Color {
+	if g, ok := groups[name]; ok {
+		if g.hasBg {
+			return g.bg
+		}
+		if g.hasFg {
+			return g.fg
+		}
+	}
+	return fallback
+}
+
+// groupStyled returns groups[name] as a StyledColor (foreground plus
+// attributes) if it defines a foreground, else fallback.
+func groupStyled(groups map[string]groupStyle, name string, fallback StyledColor) StyledColor {
+	g, ok := groups[name]
+	if !ok || !g.hasFg {
+		return fallback
+	}
+	return StyledColor{Fg: g.fg, Attrs: g.attrs}
+}
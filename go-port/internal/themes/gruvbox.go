@@ -0,0 +1,161 @@
+package themes
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// GruvboxTheme implements the Gruvbox Dark palette
+// (https://github.com/morhetz/gruvbox).
+type GruvboxTheme struct {
+	*BaseTheme
+}
+
+// NewGruvboxTheme creates a new Gruvbox Dark theme instance.
+func NewGruvboxTheme() *GruvboxTheme {
+	return &GruvboxTheme{
+		BaseTheme: NewBaseTheme("gruvbox", "Gruvbox Dark", true),
+	}
+}
+
+func (t *GruvboxTheme) GetBackgroundColor() tcell.Color {
+	return tcell.NewHexColor(0x282828) // bg0
+}
+
+func (t *GruvboxTheme) GetForegroundColor() tcell.Color {
+	return tcell.NewHexColor(0xebdbb2) // fg1
+}
+
+func (t *GruvboxTheme) GetBorderColor() tcell.Color {
+	return tcell.NewHexColor(0x3c3836) // bg1
+}
+
+func (t *GruvboxTheme) GetBorderFocusColor() tcell.Color {
+	return tcell.NewHexColor(0x458588) // blue
+}
+
+func (t *GruvboxTheme) GetTitleColor() tcell.Color {
+	return tcell.NewHexColor(0xfabd2f) // bright yellow
+}
+
+func (t *GruvboxTheme) GetStatusBgColor() tcell.Color {
+	return tcell.NewHexColor(0x3c3836) // bg1
+}
+
+func (t *GruvboxTheme) GetStatusFgColor() tcell.Color {
+	return tcell.NewHexColor(0xfbf1c7) // fg0
+}
+
+func (t *GruvboxTheme) GetInfoBgColor() tcell.Color {
+	return tcell.NewHexColor(0x282828) // bg0
+}
+
+func (t *GruvboxTheme) GetInfoFgColor() tcell.Color {
+	return tcell.NewHexColor(0xd5c4a1) // fg2
+}
+
+func (t *GruvboxTheme) GetHelpBgColor() tcell.Color {
+	return tcell.NewHexColor(0x3c3836) // bg1
+}
+
+func (t *GruvboxTheme) GetHelpFgColor() tcell.Color {
+	return tcell.NewHexColor(0x8ec07c) // bright aqua
+}
+
+func (t *GruvboxTheme) GetLineNumberBgColor() tcell.Color {
+	return tcell.NewHexColor(0x282828) // bg0
+}
+
+func (t *GruvboxTheme) GetLineNumberFgColor() tcell.Color {
+	return tcell.NewHexColor(0x7c6f64) // bg4
+}
+
+func (t *GruvboxTheme) GetLineNumberActiveFgColor() tcell.Color {
+	return tcell.NewHexColor(0xbdae93) // fg3
+}
+
+func (t *GruvboxTheme) GetSelectionBgColor() tcell.Color {
+	return tcell.NewHexColor(0x504945) // bg2
+}
+
+func (t *GruvboxTheme) GetSelectionFgColor() tcell.Color {
+	return tcell.NewHexColor(0xfbf1c7) // fg0
+}
+
+func (t *GruvboxTheme) GetCursorColor() tcell.Color {
+	return tcell.NewHexColor(0xebdbb2) // fg1
+}
+
+func (t *GruvboxTheme) GetCursorInsertColor() tcell.Color {
+	return tcell.NewHexColor(0xbdae93) // fg3
+}
+
+func (t *GruvboxTheme) GetSuccessColor() tcell.Color {
+	return tcell.NewHexColor(0xb8bb26) // bright green
+}
+
+func (t *GruvboxTheme) GetWarningColor() tcell.Color {
+	return tcell.NewHexColor(0xfabd2f) // bright yellow
+}
+
+func (t *GruvboxTheme) GetErrorColor() tcell.Color {
+	return tcell.NewHexColor(0xfb4934) // bright red
+}
+
+func (t *GruvboxTheme) GetInfoColor() tcell.Color {
+	return tcell.NewHexColor(0x83a598) // bright blue
+}
+
+func (t *GruvboxTheme) GetDimmedColor() tcell.Color {
+	return tcell.NewHexColor(0x7c6f64) // bg4
+}
+
+func (t *GruvboxTheme) GetErrorColorCode() string {
+	return "#fb4934"
+}
+
+func (t *GruvboxTheme) GetSuccessColorCode() string {
+	return "#b8bb26"
+}
+
+func (t *GruvboxTheme) GetWarningColorCode() string {
+	return "#fabd2f"
+}
+
+func (t *GruvboxTheme) GetInfoColorCode() string {
+	return "#83a598"
+}
+
+func (t *GruvboxTheme) GetSyntaxColors() SyntaxColors {
+	return SyntaxColors{
+		Heading:   StyledColor{Fg: tcell.NewHexColor(0xfabd2f), Attrs: tcell.AttrBold},
+		Emphasis:  StyledColor{Fg: tcell.NewHexColor(0xd3869b), Attrs: tcell.AttrItalic},
+		Strong:    StyledColor{Fg: tcell.NewHexColor(0xfe8019), Attrs: tcell.AttrBold},
+		Link:      StyledColor{Fg: tcell.NewHexColor(0x83a598), Attrs: tcell.AttrUnderline},
+		Code:      StyledColor{Fg: tcell.NewHexColor(0x8ec07c)},
+		CodeBlock: StyledColor{Fg: tcell.NewHexColor(0xfb4934)},
+		Quote:     StyledColor{Fg: tcell.NewHexColor(0xb8bb26), Attrs: tcell.AttrItalic},
+		List:      StyledColor{Fg: tcell.NewHexColor(0xd3869b)},
+
+		Keyword:     StyledColor{Fg: tcell.NewHexColor(0xfb4934), Attrs: tcell.AttrBold},
+		String:      StyledColor{Fg: tcell.NewHexColor(0xb8bb26)},
+		Comment:     StyledColor{Fg: tcell.NewHexColor(0x928374), Attrs: tcell.AttrDim},
+		Number:      StyledColor{Fg: tcell.NewHexColor(0xd3869b)},
+		Operator:    StyledColor{Fg: tcell.NewHexColor(0xebdbb2)},
+		Punctuation: StyledColor{Fg: tcell.NewHexColor(0xbdae93)},
+		Variable:    StyledColor{Fg: tcell.NewHexColor(0x83a598)},
+		Function:    StyledColor{Fg: tcell.NewHexColor(0xfabd2f)},
+		Type:        StyledColor{Fg: tcell.NewHexColor(0xfe8019)},
+
+		SceneHeading:  StyledColor{Fg: tcell.NewHexColor(0xfabd2f), Attrs: tcell.AttrBold},
+		Character:     StyledColor{Fg: tcell.NewHexColor(0xfe8019), Attrs: tcell.AttrBold},
+		Parenthetical: StyledColor{Fg: tcell.NewHexColor(0x928374), Attrs: tcell.AttrItalic},
+		Dialogue:      StyledColor{Fg: tcell.NewHexColor(0xebdbb2)},
+		Transition:    StyledColor{Fg: tcell.NewHexColor(0xd3869b)},
+		Note:          StyledColor{Fg: tcell.NewHexColor(0xb8bb26), Attrs: tcell.AttrItalic},
+		Boneyard:      StyledColor{Fg: tcell.NewHexColor(0x928374), Attrs: tcell.AttrDim},
+	}
+}
+
+func (t *GruvboxTheme) GetWelcomeMessage() string {
+	return "🍂 Gruvbox Dark Active - Happy Writing!"
+}
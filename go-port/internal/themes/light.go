@@ -139,25 +139,52 @@ func (t *LightTheme) GetInfoColorCode() string {
 func (t *LightTheme) GetSyntaxColors() SyntaxColors {
 	return SyntaxColors{
 		// Markdown specific colors
-		Heading:   tcell.NewHexColor(0x2b6cb0), // Strong blue for headings
-		Emphasis:  tcell.NewHexColor(0xd56565), // Warm red for italic text
-		Strong:    tcell.NewHexColor(0xd69e2e), // Amber for bold text
-		Link:      tcell.NewHexColor(0x3182ce), // Blue for links
-		Code:      tcell.NewHexColor(0x805ad5), // Purple for inline code
-		CodeBlock: tcell.NewHexColor(0xd56565), // Red for code blocks
-		Quote:     tcell.NewHexColor(0x38a169), // Green for blockquotes
-		List:      tcell.NewHexColor(0x9f7aea), // Purple for list markers
+		Heading:   StyledColor{Fg: tcell.NewHexColor(0x2b6cb0), Attrs: tcell.AttrBold},      // Strong blue for headings
+		Emphasis:  StyledColor{Fg: tcell.NewHexColor(0xd56565), Attrs: tcell.AttrItalic},    // Warm red for italic text
+		Strong:    StyledColor{Fg: tcell.NewHexColor(0xd69e2e), Attrs: tcell.AttrBold},      // Amber for bold text
+		Link:      StyledColor{Fg: tcell.NewHexColor(0x3182ce), Attrs: tcell.AttrUnderline}, // Blue for links
+		Code:      StyledColor{Fg: tcell.NewHexColor(0x805ad5)},                             // Purple for inline code
+		CodeBlock: StyledColor{Fg: tcell.NewHexColor(0xd56565)},                             // Red for code blocks
+		Quote:     StyledColor{Fg: tcell.NewHexColor(0x38a169), Attrs: tcell.AttrItalic},    // Green for blockquotes
+		List:      StyledColor{Fg: tcell.NewHexColor(0x9f7aea)},                             // Purple for list markers
 
 		// General syntax colors
-		Keyword:     tcell.NewHexColor(0x2b6cb0), // Blue for keywords
-		String:      tcell.NewHexColor(0xd56565), // Red for strings
-		Comment:     tcell.NewHexColor(0x38a169), // Green for comments
-		Number:      tcell.NewHexColor(0x38b2ac), // Teal for numbers
-		Operator:    tcell.NewHexColor(0x2d3748), // Dark for operators
-		Punctuation: tcell.NewHexColor(0x4a5568), // Gray for punctuation
-		Variable:    tcell.NewHexColor(0x3182ce), // Blue for variables
-		Function:    tcell.NewHexColor(0xd69e2e), // Amber for functions
-		Type:        tcell.NewHexColor(0x38b2ac), // Teal for types
+		Keyword:     StyledColor{Fg: tcell.NewHexColor(0x2b6cb0), Attrs: tcell.AttrBold}, // Blue for keywords
+		String:      StyledColor{Fg: tcell.NewHexColor(0xd56565)},                        // Red for strings
+		Comment:     StyledColor{Fg: tcell.NewHexColor(0x38a169), Attrs: tcell.AttrDim},  // Green for comments
+		Number:      StyledColor{Fg: tcell.NewHexColor(0x38b2ac)},                        // Teal for numbers
+		Operator:    StyledColor{Fg: tcell.NewHexColor(0x2d3748)},                        // Dark for operators
+		Punctuation: StyledColor{Fg: tcell.NewHexColor(0x4a5568)},                        // Gray for punctuation
+		Variable:    StyledColor{Fg: tcell.NewHexColor(0x3182ce)},                        // Blue for variables
+		Function:    StyledColor{Fg: tcell.NewHexColor(0xd69e2e)},                        // Amber for functions
+		Type:        StyledColor{Fg: tcell.NewHexColor(0x38b2ac)},                        // Teal for types
+
+		// Fountain screenplay colors
+		SceneHeading:  StyledColor{Fg: tcell.NewHexColor(0x2b6cb0), Attrs: tcell.AttrBold},   // Blue for sluglines
+		Character:     StyledColor{Fg: tcell.NewHexColor(0xd69e2e), Attrs: tcell.AttrBold},   // Amber for character cues
+		Parenthetical: StyledColor{Fg: tcell.NewHexColor(0x38a169), Attrs: tcell.AttrItalic}, // Green for wrylies
+		Dialogue:      StyledColor{Fg: tcell.NewHexColor(0x2d3748)},                          // Dark for dialogue
+		Transition:    StyledColor{Fg: tcell.NewHexColor(0x9f7aea)},                          // Purple for transitions
+		Note:          StyledColor{Fg: tcell.NewHexColor(0x718096), Attrs: tcell.AttrItalic}, // Gray for [[notes]]
+		Boneyard:      StyledColor{Fg: tcell.NewHexColor(0xa0aec0), Attrs: tcell.AttrDim},    // Dimmed gray for /* cut */ text
+	}
+}
+
+// GetProseColors returns prose-specific styling tuned to the light palette.
+func (t *LightTheme) GetProseColors() ProseColors {
+	return ProseColors{
+		DialogueQuote:      StyledColor{Fg: tcell.NewHexColor(0xd56565)},                          // Red, matching quoted strings
+		Footnote:           StyledColor{Fg: tcell.NewHexColor(0x38a169), Attrs: tcell.AttrItalic}, // Green, matching comments
+		CharacterName:      StyledColor{Fg: tcell.NewHexColor(0xd69e2e), Attrs: tcell.AttrBold},   // Amber, matching character cues
+		SceneHeading:       StyledColor{Fg: tcell.NewHexColor(0x2b6cb0), Attrs: tcell.AttrBold},   // Blue, matching sluglines
+		StageDirection:     StyledColor{Fg: tcell.NewHexColor(0x38a169), Attrs: tcell.AttrItalic}, // Green, matching wrylies
+		WordCountMilestone: StyledColor{Fg: tcell.NewHexColor(0x38a169), Attrs: tcell.AttrBold},   // Green success color
+		SpellError:         StyledColor{Fg: tcell.NewHexColor(0xe53e3e), Attrs: tcell.AttrUnderline},
+		GrammarWarning:     StyledColor{Fg: tcell.NewHexColor(0xd69e2e), Attrs: tcell.AttrUnderline},
+		ReadabilityEasy:    StyledColor{Fg: tcell.NewHexColor(0x38a169)},
+		ReadabilityMedium:  StyledColor{Fg: tcell.NewHexColor(0xd69e2e)},
+		ReadabilityHard:    StyledColor{Fg: tcell.NewHexColor(0xe53e3e)},
+		FocusedSentence:    StyledColor{Fg: tcell.NewHexColor(0x1a202c), Attrs: tcell.AttrBold}, // Near-black under the typewriter
 	}
 }
 
@@ -3,6 +3,8 @@ package themes
 import (
 	"fmt"
 	"sync"
+
+	"github.com/gdamore/tcell/v2"
 )
 
 // ThemeInfo holds basic information about a theme
@@ -17,6 +19,7 @@ type Manager struct {
 	themes       map[string]Theme
 	currentTheme Theme
 	defaultTheme string
+	colorProfile ColorProfile
 	mutex        sync.RWMutex
 }
 
@@ -25,12 +28,19 @@ func NewManager() *Manager {
 	manager := &Manager{
 		themes:       make(map[string]Theme),
 		defaultTheme: "dark",
+		colorProfile: ProfileTrueColor,
 	}
 
 	// Register built-in themes
 	manager.registerTheme(NewBaseTheme("base", "Base Theme", false))
 	manager.registerTheme(NewDarkTheme())
 	manager.registerTheme(NewLightTheme())
+	manager.registerTheme(NewNoColorTheme())
+	manager.registerTheme(NewSolarizedTheme())
+	manager.registerTheme(NewGruvboxTheme())
+	manager.registerTheme(NewNordTheme())
+	manager.registerTheme(NewDraculaTheme())
+	manager.registerTheme(NewMonokaiTheme())
 
 	// Set default theme
 	manager.SetTheme(manager.defaultTheme)
@@ -38,6 +48,59 @@ func NewManager() *Manager {
 	return manager
 }
 
+// DetectColorProfile sets m's color profile from DetectColorProfile(screen),
+// and - honoring $NO_COLOR - switches the active theme to NoColorTheme when
+// the environment forces ProfileMonochrome. Call this once a screen is
+// available (or with a nil screen to fall back to env detection alone),
+// before the first render.
+func (m *Manager) DetectColorProfile(screen tcell.Screen) {
+	profile := DetectColorProfile(screen)
+	m.SetColorProfile(profile)
+
+	if profile == ProfileMonochrome {
+		m.SetTheme("no-color")
+	}
+}
+
+// SetColorProfile sets the color profile ResolveColor quantizes against.
+func (m *Manager) SetColorProfile(profile ColorProfile) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.colorProfile = profile
+}
+
+// GetColorProfile returns the color profile ResolveColor quantizes against.
+func (m *Manager) GetColorProfile() ColorProfile {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.colorProfile
+}
+
+// ResolveColor downgrades c to the best approximation m's color profile can
+// render: true color is returned unchanged, ProfileMonochrome collapses
+// everything to the terminal's default, and Profile256/ProfileANSI16
+// quantize to the nearest entry in the xterm-256 or ANSI-16 palette. The
+// ColorFg/ColorBg sentinels always resolve to tcell.ColorDefault, regardless
+// of profile.
+func (m *Manager) ResolveColor(c tcell.Color) tcell.Color {
+	if c == ColorFg || c == ColorBg {
+		return tcell.ColorDefault
+	}
+
+	switch m.GetColorProfile() {
+	case ProfileTrueColor:
+		return c
+	case Profile256:
+		return nearestPaletteColor(c, 256)
+	case ProfileANSI16:
+		return nearestPaletteColor(c, 16)
+	default:
+		return tcell.ColorDefault
+	}
+}
+
 // registerTheme registers a theme without validation (internal use)
 func (m *Manager) registerTheme(theme Theme) {
 	m.mutex.Lock()
@@ -297,7 +360,8 @@ func (m *Manager) RemoveTheme(themeName string) bool {
 	defer m.mutex.Unlock()
 
 	// Don't allow removing built-in themes
-	if themeName == "base" || themeName == "dark" || themeName == "light" {
+	switch themeName {
+	case "base", "dark", "light", "no-color", "solarized", "gruvbox", "nord", "dracula", "monokai":
 		return false
 	}
 
@@ -0,0 +1,254 @@
+package themes
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// microColorschemeTheme is a Theme built from a micro editor colorscheme's
+// "color" lines, mapping each Theme role onto the micro highlight group
+// that conventionally carries it: default for the editor background,
+// comment for dimmed text, statusline for the status bar, and so on. A
+// role whose group the file never defines falls back to BaseTheme.
+type microColorschemeTheme struct {
+	*BaseTheme
+	groups map[string]groupStyle
+}
+
+// LoadMicroColorscheme parses a micro editor colorscheme file's
+//
+//	color <group> "<fg>,<bg>,<attr>,..."
+//
+// lines into a Theme - each comma-separated spec segment is either a color
+// (hex, named, or bare 256-index, the first one seen is fg and the second
+// bg) or an attribute name (bold/italic/underline/reverse/...), the same
+// convention micro's own colorscheme files use.
+func LoadMicroColorscheme(path string) (Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read micro colorscheme %s: %w", path, err)
+	}
+
+	groups, err := parseMicroColorscheme(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse micro colorscheme %s: %w", path, err)
+	}
+
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return &microColorschemeTheme{
+		BaseTheme: NewBaseTheme(name, displayNameFor(name), true),
+		groups:    groups,
+	}, nil
+}
+
+func parseMicroColorscheme(data string) (map[string]groupStyle, error) {
+	groups := make(map[string]groupStyle)
+
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.SplitN(line, " ", 3)
+		if len(fields) < 3 || fields[0] != "color" {
+			continue
+		}
+
+		group := fields[1]
+		spec := strings.Trim(strings.TrimSpace(fields[2]), `"`)
+		groups[group] = parseMicroColorValue(spec)
+	}
+
+	return groups, scanner.Err()
+}
+
+// parseMicroColorValue parses a single micro color spec - comma-separated
+// colors and attribute names in any order, such as "#f92672,bold" or
+// "#f8f8f0,#49483e". The first color segment becomes the foreground, the
+// second the background; any segment matching a styleAttrNames entry is
+// added to the attributes instead.
+func parseMicroColorValue(spec string) groupStyle {
+	var style groupStyle
+
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if attr, ok := styleAttrNames[strings.ToLower(part)]; ok {
+			style.attrs |= attr
+			continue
+		}
+		if c, ok := resolveFileColor(part); ok {
+			switch {
+			case !style.hasFg:
+				style.fg, style.hasFg = c, true
+			case !style.hasBg:
+				style.bg, style.hasBg = c, true
+			}
+		}
+	}
+
+	return style
+}
+
+func (t *microColorschemeTheme) GetBackgroundColor() tcell.Color {
+	return groupBg(t.groups, "default", t.BaseTheme.GetBackgroundColor())
+}
+
+func (t *microColorschemeTheme) GetForegroundColor() tcell.Color {
+	return groupFg(t.groups, "default", t.BaseTheme.GetForegroundColor())
+}
+
+func (t *microColorschemeTheme) GetBorderColor() tcell.Color {
+	return groupEither(t.groups, "divider", t.BaseTheme.GetBorderColor())
+}
+
+func (t *microColorschemeTheme) GetBorderFocusColor() tcell.Color {
+	return groupFg(t.groups, "special", t.BaseTheme.GetBorderFocusColor())
+}
+
+func (t *microColorschemeTheme) GetTitleColor() tcell.Color {
+	return groupFg(t.groups, "special", t.BaseTheme.GetTitleColor())
+}
+
+func (t *microColorschemeTheme) GetStatusBgColor() tcell.Color {
+	return groupBg(t.groups, "statusline", t.BaseTheme.GetStatusBgColor())
+}
+
+func (t *microColorschemeTheme) GetStatusFgColor() tcell.Color {
+	return groupFg(t.groups, "statusline", t.BaseTheme.GetStatusFgColor())
+}
+
+func (t *microColorschemeTheme) GetInfoBgColor() tcell.Color {
+	return groupBg(t.groups, "statusline", t.BaseTheme.GetInfoBgColor())
+}
+
+func (t *microColorschemeTheme) GetInfoFgColor() tcell.Color {
+	return groupFg(t.groups, "statusline", t.BaseTheme.GetInfoFgColor())
+}
+
+func (t *microColorschemeTheme) GetHelpBgColor() tcell.Color {
+	return groupBg(t.groups, "statusline", t.BaseTheme.GetHelpBgColor())
+}
+
+func (t *microColorschemeTheme) GetHelpFgColor() tcell.Color {
+	return groupFg(t.groups, "statusline", t.BaseTheme.GetHelpFgColor())
+}
+
+func (t *microColorschemeTheme) GetLineNumberBgColor() tcell.Color {
+	return groupBg(t.groups, "line-number", t.BaseTheme.GetLineNumberBgColor())
+}
+
+func (t *microColorschemeTheme) GetLineNumberFgColor() tcell.Color {
+	return groupFg(t.groups, "line-number", t.BaseTheme.GetLineNumberFgColor())
+}
+
+func (t *microColorschemeTheme) GetLineNumberActiveFgColor() tcell.Color {
+	return groupFg(t.groups, "current-line-number", t.BaseTheme.GetLineNumberActiveFgColor())
+}
+
+func (t *microColorschemeTheme) GetSelectionBgColor() tcell.Color {
+	return groupBg(t.groups, "selection", t.BaseTheme.GetSelectionBgColor())
+}
+
+func (t *microColorschemeTheme) GetSelectionFgColor() tcell.Color {
+	return groupFg(t.groups, "selection", t.BaseTheme.GetSelectionFgColor())
+}
+
+func (t *microColorschemeTheme) GetCursorColor() tcell.Color {
+	return groupEither(t.groups, "cursor-line", t.BaseTheme.GetCursorColor())
+}
+
+func (t *microColorschemeTheme) GetCursorInsertColor() tcell.Color {
+	return groupEither(t.groups, "cursor-line", t.BaseTheme.GetCursorInsertColor())
+}
+
+func (t *microColorschemeTheme) GetSuccessColor() tcell.Color {
+	return groupEither(t.groups, "diff-added", t.BaseTheme.GetSuccessColor())
+}
+
+func (t *microColorschemeTheme) GetWarningColor() tcell.Color {
+	return groupEither(t.groups, "gutter-warning", t.BaseTheme.GetWarningColor())
+}
+
+func (t *microColorschemeTheme) GetErrorColor() tcell.Color {
+	return groupEither(t.groups, "gutter-error", t.BaseTheme.GetErrorColor())
+}
+
+func (t *microColorschemeTheme) GetInfoColor() tcell.Color {
+	return groupFg(t.groups, "special", t.BaseTheme.GetInfoColor())
+}
+
+func (t *microColorschemeTheme) GetDimmedColor() tcell.Color {
+	return groupFg(t.groups, "comment", t.BaseTheme.GetDimmedColor())
+}
+
+func (t *microColorschemeTheme) GetErrorColorCode() string {
+	return hexCode(t.GetErrorColor())
+}
+
+func (t *microColorschemeTheme) GetSuccessColorCode() string {
+	return hexCode(t.GetSuccessColor())
+}
+
+func (t *microColorschemeTheme) GetWarningColorCode() string {
+	return hexCode(t.GetWarningColor())
+}
+
+func (t *microColorschemeTheme) GetInfoColorCode() string {
+	return hexCode(t.GetInfoColor())
+}
+
+func (t *microColorschemeTheme) GetSyntaxColors() SyntaxColors {
+	base := t.BaseTheme.GetSyntaxColors()
+	return SyntaxColors{
+		Heading:       groupStyled(t.groups, "special", base.Heading),
+		Emphasis:      groupStyled(t.groups, "underlined", base.Emphasis),
+		Strong:        groupStyled(t.groups, "statement", base.Strong),
+		Link:          groupStyled(t.groups, "underlined", base.Link),
+		Code:          groupStyled(t.groups, "constant.string", base.Code),
+		CodeBlock:     groupStyled(t.groups, "constant.string", base.CodeBlock),
+		Quote:         groupStyled(t.groups, "comment", base.Quote),
+		List:          groupStyled(t.groups, "special", base.List),
+		Keyword:       groupStyled(t.groups, "statement", base.Keyword),
+		String:        groupStyled(t.groups, "constant.string", base.String),
+		Comment:       groupStyled(t.groups, "comment", base.Comment),
+		Number:        groupStyled(t.groups, "constant.number", base.Number),
+		Operator:      groupStyled(t.groups, "symbol.brackets", base.Operator),
+		Punctuation:   groupStyled(t.groups, "symbol.brackets", base.Punctuation),
+		Variable:      groupStyled(t.groups, "identifier", base.Variable),
+		Function:      groupStyled(t.groups, "identifier", base.Function),
+		Type:          groupStyled(t.groups, "type", base.Type),
+		SceneHeading:  groupStyled(t.groups, "special", base.SceneHeading),
+		Character:     groupStyled(t.groups, "identifier", base.Character),
+		Parenthetical: groupStyled(t.groups, "comment", base.Parenthetical),
+		Dialogue:      groupStyled(t.groups, "default", base.Dialogue),
+		Transition:    groupStyled(t.groups, "special", base.Transition),
+		Note:          groupStyled(t.groups, "todo", base.Note),
+		Boneyard:      groupStyled(t.groups, "comment", base.Boneyard),
+	}
+}
+
+func (t *microColorschemeTheme) GetStatusAttrs() tcell.AttrMask {
+	return t.groups["statusline"].attrs
+}
+
+func (t *microColorschemeTheme) GetInfoAttrs() tcell.AttrMask {
+	return t.groups["statusline"].attrs
+}
+
+func (t *microColorschemeTheme) GetHelpAttrs() tcell.AttrMask {
+	return t.groups["statusline"].attrs
+}
+
+func (t *microColorschemeTheme) GetWelcomeMessage() string {
+	return "Theme: " + t.GetDisplayName() + " (imported from micro)"
+}
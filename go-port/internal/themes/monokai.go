@@ -0,0 +1,160 @@
+package themes
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// MonokaiTheme implements the classic Monokai palette.
+type MonokaiTheme struct {
+	*BaseTheme
+}
+
+// NewMonokaiTheme creates a new Monokai theme instance.
+func NewMonokaiTheme() *MonokaiTheme {
+	return &MonokaiTheme{
+		BaseTheme: NewBaseTheme("monokai", "Monokai", true),
+	}
+}
+
+func (t *MonokaiTheme) GetBackgroundColor() tcell.Color {
+	return tcell.NewHexColor(0x272822) // background
+}
+
+func (t *MonokaiTheme) GetForegroundColor() tcell.Color {
+	return tcell.NewHexColor(0xf8f8f2) // foreground
+}
+
+func (t *MonokaiTheme) GetBorderColor() tcell.Color {
+	return tcell.NewHexColor(0x49483e) // selection
+}
+
+func (t *MonokaiTheme) GetBorderFocusColor() tcell.Color {
+	return tcell.NewHexColor(0x66d9ef) // blue
+}
+
+func (t *MonokaiTheme) GetTitleColor() tcell.Color {
+	return tcell.NewHexColor(0xa6e22e) // green
+}
+
+func (t *MonokaiTheme) GetStatusBgColor() tcell.Color {
+	return tcell.NewHexColor(0x49483e) // selection
+}
+
+func (t *MonokaiTheme) GetStatusFgColor() tcell.Color {
+	return tcell.NewHexColor(0xf8f8f2) // foreground
+}
+
+func (t *MonokaiTheme) GetInfoBgColor() tcell.Color {
+	return tcell.NewHexColor(0x272822) // background
+}
+
+func (t *MonokaiTheme) GetInfoFgColor() tcell.Color {
+	return tcell.NewHexColor(0xf8f8f2) // foreground
+}
+
+func (t *MonokaiTheme) GetHelpBgColor() tcell.Color {
+	return tcell.NewHexColor(0x49483e) // selection
+}
+
+func (t *MonokaiTheme) GetHelpFgColor() tcell.Color {
+	return tcell.NewHexColor(0x66d9ef) // blue
+}
+
+func (t *MonokaiTheme) GetLineNumberBgColor() tcell.Color {
+	return tcell.NewHexColor(0x272822) // background
+}
+
+func (t *MonokaiTheme) GetLineNumberFgColor() tcell.Color {
+	return tcell.NewHexColor(0x75715e) // comment
+}
+
+func (t *MonokaiTheme) GetLineNumberActiveFgColor() tcell.Color {
+	return tcell.NewHexColor(0xf8f8f2) // foreground
+}
+
+func (t *MonokaiTheme) GetSelectionBgColor() tcell.Color {
+	return tcell.NewHexColor(0x49483e) // selection
+}
+
+func (t *MonokaiTheme) GetSelectionFgColor() tcell.Color {
+	return tcell.NewHexColor(0xf8f8f2) // foreground
+}
+
+func (t *MonokaiTheme) GetCursorColor() tcell.Color {
+	return tcell.NewHexColor(0xf8f8f2) // foreground
+}
+
+func (t *MonokaiTheme) GetCursorInsertColor() tcell.Color {
+	return tcell.NewHexColor(0xf92672) // pink
+}
+
+func (t *MonokaiTheme) GetSuccessColor() tcell.Color {
+	return tcell.NewHexColor(0xa6e22e) // green
+}
+
+func (t *MonokaiTheme) GetWarningColor() tcell.Color {
+	return tcell.NewHexColor(0xe6db74) // yellow
+}
+
+func (t *MonokaiTheme) GetErrorColor() tcell.Color {
+	return tcell.NewHexColor(0xf92672) // pink
+}
+
+func (t *MonokaiTheme) GetInfoColor() tcell.Color {
+	return tcell.NewHexColor(0x66d9ef) // blue
+}
+
+func (t *MonokaiTheme) GetDimmedColor() tcell.Color {
+	return tcell.NewHexColor(0x75715e) // comment
+}
+
+func (t *MonokaiTheme) GetErrorColorCode() string {
+	return "#f92672"
+}
+
+func (t *MonokaiTheme) GetSuccessColorCode() string {
+	return "#a6e22e"
+}
+
+func (t *MonokaiTheme) GetWarningColorCode() string {
+	return "#e6db74"
+}
+
+func (t *MonokaiTheme) GetInfoColorCode() string {
+	return "#66d9ef"
+}
+
+func (t *MonokaiTheme) GetSyntaxColors() SyntaxColors {
+	return SyntaxColors{
+		Heading:   StyledColor{Fg: tcell.NewHexColor(0xa6e22e), Attrs: tcell.AttrBold},
+		Emphasis:  StyledColor{Fg: tcell.NewHexColor(0xe6db74), Attrs: tcell.AttrItalic},
+		Strong:    StyledColor{Fg: tcell.NewHexColor(0xfd971f), Attrs: tcell.AttrBold},
+		Link:      StyledColor{Fg: tcell.NewHexColor(0x66d9ef), Attrs: tcell.AttrUnderline},
+		Code:      StyledColor{Fg: tcell.NewHexColor(0xae81ff)},
+		CodeBlock: StyledColor{Fg: tcell.NewHexColor(0xf92672)},
+		Quote:     StyledColor{Fg: tcell.NewHexColor(0x75715e), Attrs: tcell.AttrItalic},
+		List:      StyledColor{Fg: tcell.NewHexColor(0xae81ff)},
+
+		Keyword:     StyledColor{Fg: tcell.NewHexColor(0xf92672), Attrs: tcell.AttrBold},
+		String:      StyledColor{Fg: tcell.NewHexColor(0xe6db74)},
+		Comment:     StyledColor{Fg: tcell.NewHexColor(0x75715e), Attrs: tcell.AttrDim},
+		Number:      StyledColor{Fg: tcell.NewHexColor(0xae81ff)},
+		Operator:    StyledColor{Fg: tcell.NewHexColor(0xf92672)},
+		Punctuation: StyledColor{Fg: tcell.NewHexColor(0xf8f8f2)},
+		Variable:    StyledColor{Fg: tcell.NewHexColor(0xf8f8f2)},
+		Function:    StyledColor{Fg: tcell.NewHexColor(0xa6e22e)},
+		Type:        StyledColor{Fg: tcell.NewHexColor(0x66d9ef)},
+
+		SceneHeading:  StyledColor{Fg: tcell.NewHexColor(0xa6e22e), Attrs: tcell.AttrBold},
+		Character:     StyledColor{Fg: tcell.NewHexColor(0xfd971f), Attrs: tcell.AttrBold},
+		Parenthetical: StyledColor{Fg: tcell.NewHexColor(0x75715e), Attrs: tcell.AttrItalic},
+		Dialogue:      StyledColor{Fg: tcell.NewHexColor(0xf8f8f2)},
+		Transition:    StyledColor{Fg: tcell.NewHexColor(0xae81ff)},
+		Note:          StyledColor{Fg: tcell.NewHexColor(0xa6e22e), Attrs: tcell.AttrItalic},
+		Boneyard:      StyledColor{Fg: tcell.NewHexColor(0x75715e), Attrs: tcell.AttrDim},
+	}
+}
+
+func (t *MonokaiTheme) GetWelcomeMessage() string {
+	return "🎨 Monokai Active - Happy Writing!"
+}
@@ -0,0 +1,99 @@
+package themes
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// NoColorTheme is the theme applied when $NO_COLOR is set: every color
+// getter resolves to ColorFg or ColorBg, so the terminal's own default
+// palette is used throughout instead of any hardcoded color.
+type NoColorTheme struct {
+	*BaseTheme
+}
+
+// NewNoColorTheme creates the NO_COLOR fallback theme.
+func NewNoColorTheme() *NoColorTheme {
+	return &NoColorTheme{
+		BaseTheme: NewBaseTheme("no-color", "No Color", false),
+	}
+}
+
+func (t *NoColorTheme) GetBackgroundColor() tcell.Color         { return ColorBg }
+func (t *NoColorTheme) GetForegroundColor() tcell.Color         { return ColorFg }
+func (t *NoColorTheme) GetBorderColor() tcell.Color             { return ColorFg }
+func (t *NoColorTheme) GetBorderFocusColor() tcell.Color        { return ColorFg }
+func (t *NoColorTheme) GetTitleColor() tcell.Color              { return ColorFg }
+func (t *NoColorTheme) GetStatusBgColor() tcell.Color           { return ColorBg }
+func (t *NoColorTheme) GetStatusFgColor() tcell.Color           { return ColorFg }
+func (t *NoColorTheme) GetInfoBgColor() tcell.Color             { return ColorBg }
+func (t *NoColorTheme) GetInfoFgColor() tcell.Color             { return ColorFg }
+func (t *NoColorTheme) GetHelpBgColor() tcell.Color             { return ColorBg }
+func (t *NoColorTheme) GetHelpFgColor() tcell.Color             { return ColorFg }
+func (t *NoColorTheme) GetLineNumberBgColor() tcell.Color       { return ColorBg }
+func (t *NoColorTheme) GetLineNumberFgColor() tcell.Color       { return ColorFg }
+func (t *NoColorTheme) GetLineNumberActiveFgColor() tcell.Color { return ColorFg }
+func (t *NoColorTheme) GetSelectionBgColor() tcell.Color        { return ColorBg }
+func (t *NoColorTheme) GetSelectionFgColor() tcell.Color        { return ColorFg }
+func (t *NoColorTheme) GetCursorColor() tcell.Color             { return ColorFg }
+func (t *NoColorTheme) GetCursorInsertColor() tcell.Color       { return ColorFg }
+func (t *NoColorTheme) GetSuccessColor() tcell.Color            { return ColorFg }
+func (t *NoColorTheme) GetWarningColor() tcell.Color            { return ColorFg }
+func (t *NoColorTheme) GetErrorColor() tcell.Color              { return ColorFg }
+func (t *NoColorTheme) GetInfoColor() tcell.Color               { return ColorFg }
+func (t *NoColorTheme) GetDimmedColor() tcell.Color             { return ColorFg }
+
+// Color codes for markup - "-" is tview's "reset to default" tag.
+func (t *NoColorTheme) GetErrorColorCode() string   { return "-" }
+func (t *NoColorTheme) GetSuccessColorCode() string { return "-" }
+func (t *NoColorTheme) GetWarningColorCode() string { return "-" }
+func (t *NoColorTheme) GetInfoColorCode() string    { return "-" }
+
+func (t *NoColorTheme) GetSyntaxColors() SyntaxColors {
+	plain := StyledColor{Fg: ColorFg}
+	return SyntaxColors{
+		Heading:       plain,
+		Emphasis:      plain,
+		Strong:        plain,
+		Link:          plain,
+		Code:          plain,
+		CodeBlock:     plain,
+		Quote:         plain,
+		List:          plain,
+		Keyword:       plain,
+		String:        plain,
+		Comment:       plain,
+		Number:        plain,
+		Operator:      plain,
+		Punctuation:   plain,
+		Variable:      plain,
+		Function:      plain,
+		Type:          plain,
+		SceneHeading:  plain,
+		Character:     plain,
+		Parenthetical: plain,
+		Dialogue:      plain,
+		Transition:    plain,
+		Note:          plain,
+		Boneyard:      plain,
+	}
+}
+
+func (t *NoColorTheme) GetProseColors() ProseColors {
+	plain := StyledColor{Fg: ColorFg}
+	return ProseColors{
+		DialogueQuote: plain, Footnote: plain, CharacterName: plain,
+		SceneHeading: plain, StageDirection: plain, WordCountMilestone: plain,
+		SpellError: plain, GrammarWarning: plain, ReadabilityEasy: plain,
+		ReadabilityMedium: plain, ReadabilityHard: plain, FocusedSentence: plain,
+	}
+}
+
+// GetStatusAttrs, GetInfoAttrs, and GetHelpAttrs return no attributes -
+// $NO_COLOR means no styling at all, not just no color.
+func (t *NoColorTheme) GetStatusAttrs() tcell.AttrMask { return tcell.AttrNone }
+func (t *NoColorTheme) GetInfoAttrs() tcell.AttrMask   { return tcell.AttrNone }
+func (t *NoColorTheme) GetHelpAttrs() tcell.AttrMask   { return tcell.AttrNone }
+
+func (t *NoColorTheme) GetWelcomeMessage() string {
+	return "Welcome to Writers CLI Editor"
+}
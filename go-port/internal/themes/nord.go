@@ -0,0 +1,160 @@
+package themes
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// NordTheme implements the Nord palette (https://www.nordtheme.com/).
+type NordTheme struct {
+	*BaseTheme
+}
+
+// NewNordTheme creates a new Nord theme instance.
+func NewNordTheme() *NordTheme {
+	return &NordTheme{
+		BaseTheme: NewBaseTheme("nord", "Nord", true),
+	}
+}
+
+func (t *NordTheme) GetBackgroundColor() tcell.Color {
+	return tcell.NewHexColor(0x2e3440) // nord0
+}
+
+func (t *NordTheme) GetForegroundColor() tcell.Color {
+	return tcell.NewHexColor(0xd8dee9) // nord4
+}
+
+func (t *NordTheme) GetBorderColor() tcell.Color {
+	return tcell.NewHexColor(0x3b4252) // nord1
+}
+
+func (t *NordTheme) GetBorderFocusColor() tcell.Color {
+	return tcell.NewHexColor(0x88c0d0) // nord8
+}
+
+func (t *NordTheme) GetTitleColor() tcell.Color {
+	return tcell.NewHexColor(0x8fbcbb) // nord7
+}
+
+func (t *NordTheme) GetStatusBgColor() tcell.Color {
+	return tcell.NewHexColor(0x3b4252) // nord1
+}
+
+func (t *NordTheme) GetStatusFgColor() tcell.Color {
+	return tcell.NewHexColor(0xeceff4) // nord6
+}
+
+func (t *NordTheme) GetInfoBgColor() tcell.Color {
+	return tcell.NewHexColor(0x2e3440) // nord0
+}
+
+func (t *NordTheme) GetInfoFgColor() tcell.Color {
+	return tcell.NewHexColor(0xe5e9f0) // nord5
+}
+
+func (t *NordTheme) GetHelpBgColor() tcell.Color {
+	return tcell.NewHexColor(0x3b4252) // nord1
+}
+
+func (t *NordTheme) GetHelpFgColor() tcell.Color {
+	return tcell.NewHexColor(0x88c0d0) // nord8
+}
+
+func (t *NordTheme) GetLineNumberBgColor() tcell.Color {
+	return tcell.NewHexColor(0x2e3440) // nord0
+}
+
+func (t *NordTheme) GetLineNumberFgColor() tcell.Color {
+	return tcell.NewHexColor(0x4c566a) // nord3
+}
+
+func (t *NordTheme) GetLineNumberActiveFgColor() tcell.Color {
+	return tcell.NewHexColor(0xd8dee9) // nord4
+}
+
+func (t *NordTheme) GetSelectionBgColor() tcell.Color {
+	return tcell.NewHexColor(0x434c5e) // nord2
+}
+
+func (t *NordTheme) GetSelectionFgColor() tcell.Color {
+	return tcell.NewHexColor(0xeceff4) // nord6
+}
+
+func (t *NordTheme) GetCursorColor() tcell.Color {
+	return tcell.NewHexColor(0xd8dee9) // nord4
+}
+
+func (t *NordTheme) GetCursorInsertColor() tcell.Color {
+	return tcell.NewHexColor(0x81a1c1) // nord9
+}
+
+func (t *NordTheme) GetSuccessColor() tcell.Color {
+	return tcell.NewHexColor(0xa3be8c) // nord14
+}
+
+func (t *NordTheme) GetWarningColor() tcell.Color {
+	return tcell.NewHexColor(0xebcb8b) // nord13
+}
+
+func (t *NordTheme) GetErrorColor() tcell.Color {
+	return tcell.NewHexColor(0xbf616a) // nord11
+}
+
+func (t *NordTheme) GetInfoColor() tcell.Color {
+	return tcell.NewHexColor(0x81a1c1) // nord9
+}
+
+func (t *NordTheme) GetDimmedColor() tcell.Color {
+	return tcell.NewHexColor(0x4c566a) // nord3
+}
+
+func (t *NordTheme) GetErrorColorCode() string {
+	return "#bf616a"
+}
+
+func (t *NordTheme) GetSuccessColorCode() string {
+	return "#a3be8c"
+}
+
+func (t *NordTheme) GetWarningColorCode() string {
+	return "#ebcb8b"
+}
+
+func (t *NordTheme) GetInfoColorCode() string {
+	return "#81a1c1"
+}
+
+func (t *NordTheme) GetSyntaxColors() SyntaxColors {
+	return SyntaxColors{
+		Heading:   StyledColor{Fg: tcell.NewHexColor(0x88c0d0), Attrs: tcell.AttrBold},
+		Emphasis:  StyledColor{Fg: tcell.NewHexColor(0xb48ead), Attrs: tcell.AttrItalic},
+		Strong:    StyledColor{Fg: tcell.NewHexColor(0xd08770), Attrs: tcell.AttrBold},
+		Link:      StyledColor{Fg: tcell.NewHexColor(0x81a1c1), Attrs: tcell.AttrUnderline},
+		Code:      StyledColor{Fg: tcell.NewHexColor(0x8fbcbb)},
+		CodeBlock: StyledColor{Fg: tcell.NewHexColor(0xbf616a)},
+		Quote:     StyledColor{Fg: tcell.NewHexColor(0xa3be8c), Attrs: tcell.AttrItalic},
+		List:      StyledColor{Fg: tcell.NewHexColor(0xb48ead)},
+
+		Keyword:     StyledColor{Fg: tcell.NewHexColor(0x81a1c1), Attrs: tcell.AttrBold},
+		String:      StyledColor{Fg: tcell.NewHexColor(0xa3be8c)},
+		Comment:     StyledColor{Fg: tcell.NewHexColor(0x4c566a), Attrs: tcell.AttrDim},
+		Number:      StyledColor{Fg: tcell.NewHexColor(0xb48ead)},
+		Operator:    StyledColor{Fg: tcell.NewHexColor(0xd8dee9)},
+		Punctuation: StyledColor{Fg: tcell.NewHexColor(0xe5e9f0)},
+		Variable:    StyledColor{Fg: tcell.NewHexColor(0xd8dee9)},
+		Function:    StyledColor{Fg: tcell.NewHexColor(0x88c0d0)},
+		Type:        StyledColor{Fg: tcell.NewHexColor(0x8fbcbb)},
+
+		SceneHeading:  StyledColor{Fg: tcell.NewHexColor(0x88c0d0), Attrs: tcell.AttrBold},
+		Character:     StyledColor{Fg: tcell.NewHexColor(0xd08770), Attrs: tcell.AttrBold},
+		Parenthetical: StyledColor{Fg: tcell.NewHexColor(0x4c566a), Attrs: tcell.AttrItalic},
+		Dialogue:      StyledColor{Fg: tcell.NewHexColor(0xd8dee9)},
+		Transition:    StyledColor{Fg: tcell.NewHexColor(0xb48ead)},
+		Note:          StyledColor{Fg: tcell.NewHexColor(0xa3be8c), Attrs: tcell.AttrItalic},
+		Boneyard:      StyledColor{Fg: tcell.NewHexColor(0x4c566a), Attrs: tcell.AttrDim},
+	}
+}
+
+func (t *NordTheme) GetWelcomeMessage() string {
+	return "❄️ Nord Active - Happy Writing!"
+}
@@ -0,0 +1,106 @@
+package themes
+
+import (
+	"os"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// ColorProfile is the range of colors a terminal can render, from richest
+// to poorest.
+type ColorProfile int
+
+const (
+	// ProfileTrueColor supports full 24-bit RGB.
+	ProfileTrueColor ColorProfile = iota
+	// Profile256 supports the xterm 256-color palette.
+	Profile256
+	// ProfileANSI16 supports only the 16 standard ANSI colors.
+	ProfileANSI16
+	// ProfileMonochrome supports no color at all; every color resolves to
+	// the terminal's own default.
+	ProfileMonochrome
+)
+
+// ColorFg and ColorBg are sentinel colors meaning "the terminal's own
+// default foreground/background", the way amfora's ColorSpecial flag lets a
+// colorscheme say "leave this alone" instead of hardcoding white or black -
+// important on transparent terminals. Manager.ResolveColor maps both to
+// tcell.ColorDefault; the two are kept distinct so a theme can still tell a
+// default foreground role apart from a default background role.
+const (
+	ColorFg tcell.Color = tcell.ColorSpecial | 1
+	ColorBg tcell.Color = tcell.ColorSpecial | 2
+)
+
+// DetectColorProfile determines the richest ColorProfile the environment
+// supports. $NO_COLOR (see https://no-color.org) always wins and forces
+// ProfileMonochrome. When screen is non-nil, its negotiated Colors() count
+// is authoritative; otherwise the profile is guessed from $COLORTERM and
+// $TERM, mirroring how tcell-based editors such as micro negotiate a
+// palette before a screen is available.
+func DetectColorProfile(screen tcell.Screen) ColorProfile {
+	if os.Getenv("NO_COLOR") != "" {
+		return ProfileMonochrome
+	}
+	if screen != nil {
+		return profileFromColorCount(screen.Colors())
+	}
+	return profileFromEnv()
+}
+
+func profileFromColorCount(colors int) ColorProfile {
+	switch {
+	case colors >= 1<<24:
+		return ProfileTrueColor
+	case colors >= 256:
+		return Profile256
+	case colors >= 8:
+		return ProfileANSI16
+	default:
+		return ProfileMonochrome
+	}
+}
+
+func profileFromEnv() ColorProfile {
+	term := os.Getenv("TERM")
+	if term == "" || term == "dumb" {
+		return ProfileMonochrome
+	}
+
+	switch strings.ToLower(os.Getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return ProfileTrueColor
+	}
+
+	if strings.Contains(term, "256color") {
+		return Profile256
+	}
+
+	return ProfileANSI16
+}
+
+// nearestPaletteColor returns the color among the first count palette
+// entries (PaletteColor(0)..PaletteColor(count-1)) closest to c in RGB
+// space, by squared Euclidean distance. Colors tcell can't break into RGB
+// (ColorDefault, the ColorFg/ColorBg sentinels) are returned unchanged.
+func nearestPaletteColor(c tcell.Color, count int) tcell.Color {
+	r, g, b := c.RGB()
+	if r < 0 {
+		return c
+	}
+
+	best := tcell.PaletteColor(0)
+	bestDist := int64(-1)
+	for i := 0; i < count; i++ {
+		candidate := tcell.PaletteColor(i)
+		pr, pg, pb := candidate.RGB()
+		dr, dg, db := int64(r-pr), int64(g-pg), int64(b-pb)
+		if dist := dr*dr + dg*dg + db*db; bestDist < 0 || dist < bestDist {
+			bestDist = dist
+			best = candidate
+		}
+	}
+	return best
+}
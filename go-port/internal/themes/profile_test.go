@@ -0,0 +1,149 @@
+package themes
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+func TestProfileFromColorCount(t *testing.T) {
+	cases := []struct {
+		colors int
+		want   ColorProfile
+	}{
+		{1 << 24, ProfileTrueColor},
+		{1 << 24 * 2, ProfileTrueColor},
+		{256, Profile256},
+		{16, ProfileANSI16},
+		{8, ProfileANSI16},
+		{2, ProfileMonochrome},
+	}
+	for _, c := range cases {
+		if got := profileFromColorCount(c.colors); got != c.want {
+			t.Errorf("profileFromColorCount(%d) = %v, want %v", c.colors, got, c.want)
+		}
+	}
+}
+
+func TestProfileFromEnv(t *testing.T) {
+	cases := []struct {
+		name      string
+		term      string
+		colorterm string
+		want      ColorProfile
+	}{
+		{"no term", "", "", ProfileMonochrome},
+		{"dumb term", "dumb", "", ProfileMonochrome},
+		{"truecolor colorterm", "xterm", "truecolor", ProfileTrueColor},
+		{"24bit colorterm", "xterm", "24bit", ProfileTrueColor},
+		{"256color term", "xterm-256color", "", Profile256},
+		{"plain term", "xterm", "", ProfileANSI16},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Setenv("TERM", c.term)
+			t.Setenv("COLORTERM", c.colorterm)
+			t.Setenv("NO_COLOR", "")
+			if got := profileFromEnv(); got != c.want {
+				t.Errorf("profileFromEnv() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestDetectColorProfileNoColorEnvWins(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	t.Setenv("TERM", "xterm-256color")
+	t.Setenv("COLORTERM", "truecolor")
+
+	if got := DetectColorProfile(nil); got != ProfileMonochrome {
+		t.Errorf("DetectColorProfile() = %v, want ProfileMonochrome", got)
+	}
+}
+
+func TestDetectColorProfileFallsBackToEnv(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("TERM", "xterm-256color")
+	t.Setenv("COLORTERM", "")
+
+	if got := DetectColorProfile(nil); got != Profile256 {
+		t.Errorf("DetectColorProfile(nil) = %v, want Profile256", got)
+	}
+}
+
+func TestNearestPaletteColorExactMatch(t *testing.T) {
+	want := tcell.PaletteColor(5)
+	got := nearestPaletteColor(want, 256)
+	if got != want {
+		t.Errorf("nearestPaletteColor(%v) = %v, want itself", want, got)
+	}
+}
+
+func TestNearestPaletteColorPassesThroughUnresolvable(t *testing.T) {
+	if got := nearestPaletteColor(tcell.ColorDefault, 256); got != tcell.ColorDefault {
+		t.Errorf("nearestPaletteColor(ColorDefault) = %v, want ColorDefault unchanged", got)
+	}
+}
+
+func TestResolveColorTrueColorPassesThrough(t *testing.T) {
+	m := NewManager()
+	m.SetColorProfile(ProfileTrueColor)
+
+	c := tcell.NewRGBColor(12, 34, 56)
+	if got := m.ResolveColor(c); got != c {
+		t.Errorf("ResolveColor() = %v, want unchanged %v", got, c)
+	}
+}
+
+func TestResolveColorMonochromeCollapsesToDefault(t *testing.T) {
+	m := NewManager()
+	m.SetColorProfile(ProfileMonochrome)
+
+	if got := m.ResolveColor(tcell.NewRGBColor(200, 10, 10)); got != tcell.ColorDefault {
+		t.Errorf("ResolveColor() = %v, want ColorDefault", got)
+	}
+}
+
+func TestResolveColorSentinelsAlwaysResolveToDefault(t *testing.T) {
+	m := NewManager()
+	m.SetColorProfile(ProfileTrueColor)
+
+	if got := m.ResolveColor(ColorFg); got != tcell.ColorDefault {
+		t.Errorf("ResolveColor(ColorFg) = %v, want ColorDefault", got)
+	}
+	if got := m.ResolveColor(ColorBg); got != tcell.ColorDefault {
+		t.Errorf("ResolveColor(ColorBg) = %v, want ColorDefault", got)
+	}
+}
+
+func TestResolveColorQuantizesToPalette(t *testing.T) {
+	m := NewManager()
+	m.SetColorProfile(ProfileANSI16)
+
+	got := m.ResolveColor(tcell.NewRGBColor(250, 0, 0))
+	gr, gg, gb := got.RGB()
+	if gr < 0 {
+		t.Fatalf("ResolveColor() returned a non-RGB color %v", got)
+	}
+
+	match := false
+	for i := 0; i < 16; i++ {
+		pr, pg, pb := tcell.PaletteColor(i).RGB()
+		if pr == gr && pg == gg && pb == gb {
+			match = true
+			break
+		}
+	}
+	if !match {
+		t.Errorf("ResolveColor() = %v, not one of the first 16 palette colors", got)
+	}
+}
+
+func TestSetGetColorProfile(t *testing.T) {
+	m := NewManager()
+	m.SetColorProfile(Profile256)
+	if got := m.GetColorProfile(); got != Profile256 {
+		t.Errorf("GetColorProfile() = %v, want Profile256", got)
+	}
+}
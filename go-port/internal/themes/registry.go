@@ -0,0 +1,137 @@
+package themes
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ThemeRegistry is a Manager plus the ability to merge in user-supplied
+// TOML/YAML theme files from a directory, so authors can share color
+// schemes without recompiling.
+type ThemeRegistry struct {
+	*Manager
+}
+
+// NewThemeRegistry creates a ThemeRegistry with only the built-in themes
+// registered; call LoadThemesDir to merge in user-supplied theme files.
+func NewThemeRegistry() *ThemeRegistry {
+	return &ThemeRegistry{Manager: NewManager()}
+}
+
+// LoadThemeFile registers the theme loaded from a single TOML/YAML file,
+// such as ~/.config/writers-cli/themes/solarized.toml. See LoadFromFile for
+// the file format.
+func (r *ThemeRegistry) LoadThemeFile(path string) error {
+	theme, err := LoadFromFile(path)
+	if err != nil {
+		return err
+	}
+	return r.RegisterTheme(theme)
+}
+
+// LoadThemesDir registers every theme file LoadFromDir finds in dir,
+// returning one error per file that failed to parse. A missing dir isn't an
+// error - it just means no user themes are installed.
+func (r *ThemeRegistry) LoadThemesDir(dir string) []error {
+	themes, errs := LoadFromDir(dir)
+	for _, theme := range themes {
+		if err := r.RegisterTheme(theme); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", theme.GetName(), err))
+		}
+	}
+	return errs
+}
+
+// LoadColorschemeFile registers the theme loaded from a single imported
+// Vim/Neovim (".vim") or micro (".micro") colorscheme file, dispatching to
+// LoadVimColorscheme or LoadMicroColorscheme by extension.
+func (r *ThemeRegistry) LoadColorschemeFile(path string) error {
+	theme, err := loadColorscheme(path)
+	if err != nil {
+		return err
+	}
+	return r.RegisterTheme(theme)
+}
+
+// LoadColorschemesDir registers every ".vim"/".micro" colorscheme file found
+// directly in dir, returning one error per file that failed to parse. A
+// missing dir isn't an error - it just means no colorschemes have been
+// imported yet.
+func (r *ThemeRegistry) LoadColorschemesDir(dir string) []error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	var errs []error
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".vim", ".micro":
+		default:
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		theme, err := loadColorscheme(path)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+		if err := r.RegisterTheme(theme); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+		}
+	}
+	return errs
+}
+
+// loadColorscheme dispatches path to LoadVimColorscheme or
+// LoadMicroColorscheme by its ".vim"/".micro" extension.
+func loadColorscheme(path string) (Theme, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".vim":
+		return LoadVimColorscheme(path)
+	case ".micro":
+		return LoadMicroColorscheme(path)
+	default:
+		return nil, fmt.Errorf("unrecognized colorscheme extension for %s (expected .vim or .micro)", path)
+	}
+}
+
+// LoadFromDir loads every ".toml"/".yaml"/".yml"/".json" theme file found
+// directly in dir (see LoadFromFile for the file format), returning one
+// error per file that failed to parse. A missing dir isn't an error - it
+// just means no user themes are installed.
+func LoadFromDir(dir string) ([]Theme, []error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil
+	}
+
+	var themes []Theme
+	var errs []error
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".toml", ".yaml", ".yml", ".json":
+		default:
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		theme, err := LoadFromFile(path)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+		themes = append(themes, theme)
+	}
+
+	return themes, errs
+}
@@ -0,0 +1,161 @@
+package themes
+
+import (
+	"github.com/gdamore/tcell/v2"
+)
+
+// SolarizedTheme implements Ethan Schoonover's Solarized Dark palette
+// (https://ethanschoonover.com/solarized/).
+type SolarizedTheme struct {
+	*BaseTheme
+}
+
+// NewSolarizedTheme creates a new Solarized Dark theme instance.
+func NewSolarizedTheme() *SolarizedTheme {
+	return &SolarizedTheme{
+		BaseTheme: NewBaseTheme("solarized", "Solarized Dark", true),
+	}
+}
+
+func (t *SolarizedTheme) GetBackgroundColor() tcell.Color {
+	return tcell.NewHexColor(0x002b36) // base03
+}
+
+func (t *SolarizedTheme) GetForegroundColor() tcell.Color {
+	return tcell.NewHexColor(0x839496) // base0
+}
+
+func (t *SolarizedTheme) GetBorderColor() tcell.Color {
+	return tcell.NewHexColor(0x073642) // base02
+}
+
+func (t *SolarizedTheme) GetBorderFocusColor() tcell.Color {
+	return tcell.NewHexColor(0x268bd2) // blue
+}
+
+func (t *SolarizedTheme) GetTitleColor() tcell.Color {
+	return tcell.NewHexColor(0x2aa198) // cyan
+}
+
+func (t *SolarizedTheme) GetStatusBgColor() tcell.Color {
+	return tcell.NewHexColor(0x073642) // base02
+}
+
+func (t *SolarizedTheme) GetStatusFgColor() tcell.Color {
+	return tcell.NewHexColor(0x93a1a1) // base1
+}
+
+func (t *SolarizedTheme) GetInfoBgColor() tcell.Color {
+	return tcell.NewHexColor(0x002b36) // base03
+}
+
+func (t *SolarizedTheme) GetInfoFgColor() tcell.Color {
+	return tcell.NewHexColor(0x839496) // base0
+}
+
+func (t *SolarizedTheme) GetHelpBgColor() tcell.Color {
+	return tcell.NewHexColor(0x073642) // base02
+}
+
+func (t *SolarizedTheme) GetHelpFgColor() tcell.Color {
+	return tcell.NewHexColor(0x2aa198) // cyan
+}
+
+func (t *SolarizedTheme) GetLineNumberBgColor() tcell.Color {
+	return tcell.NewHexColor(0x002b36) // base03
+}
+
+func (t *SolarizedTheme) GetLineNumberFgColor() tcell.Color {
+	return tcell.NewHexColor(0x586e75) // base01
+}
+
+func (t *SolarizedTheme) GetLineNumberActiveFgColor() tcell.Color {
+	return tcell.NewHexColor(0x93a1a1) // base1
+}
+
+func (t *SolarizedTheme) GetSelectionBgColor() tcell.Color {
+	return tcell.NewHexColor(0x073642) // base02
+}
+
+func (t *SolarizedTheme) GetSelectionFgColor() tcell.Color {
+	return tcell.NewHexColor(0xeee8d5) // base2
+}
+
+func (t *SolarizedTheme) GetCursorColor() tcell.Color {
+	return tcell.NewHexColor(0x839496) // base0
+}
+
+func (t *SolarizedTheme) GetCursorInsertColor() tcell.Color {
+	return tcell.NewHexColor(0x93a1a1) // base1
+}
+
+func (t *SolarizedTheme) GetSuccessColor() tcell.Color {
+	return tcell.NewHexColor(0x859900) // green
+}
+
+func (t *SolarizedTheme) GetWarningColor() tcell.Color {
+	return tcell.NewHexColor(0xb58900) // yellow
+}
+
+func (t *SolarizedTheme) GetErrorColor() tcell.Color {
+	return tcell.NewHexColor(0xdc322f) // red
+}
+
+func (t *SolarizedTheme) GetInfoColor() tcell.Color {
+	return tcell.NewHexColor(0x268bd2) // blue
+}
+
+func (t *SolarizedTheme) GetDimmedColor() tcell.Color {
+	return tcell.NewHexColor(0x586e75) // base01
+}
+
+func (t *SolarizedTheme) GetErrorColorCode() string {
+	return "#dc322f"
+}
+
+func (t *SolarizedTheme) GetSuccessColorCode() string {
+	return "#859900"
+}
+
+func (t *SolarizedTheme) GetWarningColorCode() string {
+	return "#b58900"
+}
+
+func (t *SolarizedTheme) GetInfoColorCode() string {
+	return "#268bd2"
+}
+
+func (t *SolarizedTheme) GetSyntaxColors() SyntaxColors {
+	return SyntaxColors{
+		Heading:   StyledColor{Fg: tcell.NewHexColor(0x268bd2), Attrs: tcell.AttrBold},
+		Emphasis:  StyledColor{Fg: tcell.NewHexColor(0xb58900), Attrs: tcell.AttrItalic},
+		Strong:    StyledColor{Fg: tcell.NewHexColor(0xcb4b16), Attrs: tcell.AttrBold},
+		Link:      StyledColor{Fg: tcell.NewHexColor(0x268bd2), Attrs: tcell.AttrUnderline},
+		Code:      StyledColor{Fg: tcell.NewHexColor(0x2aa198)},
+		CodeBlock: StyledColor{Fg: tcell.NewHexColor(0xdc322f)},
+		Quote:     StyledColor{Fg: tcell.NewHexColor(0x859900), Attrs: tcell.AttrItalic},
+		List:      StyledColor{Fg: tcell.NewHexColor(0x6c71c4)},
+
+		Keyword:     StyledColor{Fg: tcell.NewHexColor(0x859900), Attrs: tcell.AttrBold},
+		String:      StyledColor{Fg: tcell.NewHexColor(0x2aa198)},
+		Comment:     StyledColor{Fg: tcell.NewHexColor(0x586e75), Attrs: tcell.AttrDim},
+		Number:      StyledColor{Fg: tcell.NewHexColor(0xd33682)},
+		Operator:    StyledColor{Fg: tcell.NewHexColor(0x839496)},
+		Punctuation: StyledColor{Fg: tcell.NewHexColor(0x93a1a1)},
+		Variable:    StyledColor{Fg: tcell.NewHexColor(0x268bd2)},
+		Function:    StyledColor{Fg: tcell.NewHexColor(0xb58900)},
+		Type:        StyledColor{Fg: tcell.NewHexColor(0xcb4b16)},
+
+		SceneHeading:  StyledColor{Fg: tcell.NewHexColor(0x268bd2), Attrs: tcell.AttrBold},
+		Character:     StyledColor{Fg: tcell.NewHexColor(0xb58900), Attrs: tcell.AttrBold},
+		Parenthetical: StyledColor{Fg: tcell.NewHexColor(0x586e75), Attrs: tcell.AttrItalic},
+		Dialogue:      StyledColor{Fg: tcell.NewHexColor(0x839496)},
+		Transition:    StyledColor{Fg: tcell.NewHexColor(0x6c71c4)},
+		Note:          StyledColor{Fg: tcell.NewHexColor(0x859900), Attrs: tcell.AttrItalic},
+		Boneyard:      StyledColor{Fg: tcell.NewHexColor(0x586e75), Attrs: tcell.AttrDim},
+	}
+}
+
+func (t *SolarizedTheme) GetWelcomeMessage() string {
+	return "🌞 Solarized Dark Active - Happy Writing!"
+}
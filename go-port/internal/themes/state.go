@@ -0,0 +1,177 @@
+package themes
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// ProjectThemeFile is the name of the per-project theme override marker,
+// read from <project root>/.writers/theme. It holds nothing but a theme
+// name, e.g. "gruvbox".
+const ProjectThemeFile = "theme"
+
+// persistedState is the on-disk shape SaveState/LoadState read and write.
+type persistedState struct {
+	Theme string `json:"theme"`
+}
+
+// DefaultStatePath returns ~/.writers-cli/theme-state.json, the state file
+// SaveState/LoadState use when a caller has no project-specific path of its
+// own, creating the containing directory if necessary.
+func DefaultStatePath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(home, ".writers-cli")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "theme-state.json"), nil
+}
+
+// SaveState writes m's active theme name to path, so the next LoadState call
+// (in this or a future session) can restore it.
+func (m *Manager) SaveState(path string) error {
+	state := persistedState{Theme: m.GetCurrentTheme().GetName()}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+// LoadState restores the theme last saved to path via SaveState. A missing
+// file, or a theme name the manager doesn't recognize, is not an error - the
+// manager is simply left on whatever theme it already had. It reports
+// whether a theme was actually restored, so callers can fall back to some
+// other preference (e.g. the OS appearance) when nothing was persisted.
+func (m *Manager) LoadState(path string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	var state persistedState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return false, err
+	}
+
+	if state.Theme == "" {
+		return false, nil
+	}
+	return m.SetTheme(state.Theme), nil
+}
+
+// LoadProjectTheme looks for a .writers/theme marker under projectPath and,
+// if one is present and names a registered theme, activates it - overriding
+// whatever the global state (SaveState/LoadState) preferred. Call this once
+// a project is opened, after LoadState. It reports whether a project
+// override was found and applied.
+func (m *Manager) LoadProjectTheme(projectPath string) bool {
+	data, err := os.ReadFile(filepath.Join(projectPath, ".writers", ProjectThemeFile))
+	if err != nil {
+		return false
+	}
+
+	name := strings.TrimSpace(string(data))
+	if name == "" {
+		return false
+	}
+
+	return m.SetTheme(name)
+}
+
+// SetThemeBySystemAppearance switches to the "dark" or "light" built-in
+// theme to match the OS's current appearance, checked in order via
+// $DARK_MODE, macOS's AppleInterfaceStyle default, and the freedesktop
+// org.freedesktop.appearance color-scheme portal (GNOME/KDE under
+// xdg-desktop-portal). It reports whether a system preference was found and
+// applied; with none available, the active theme is left unchanged.
+func (m *Manager) SetThemeBySystemAppearance() bool {
+	if dark, ok := darkModeFromEnv(); ok {
+		return m.setAppearanceTheme(dark)
+	}
+	if dark, ok := darkModeFromMacOS(); ok {
+		return m.setAppearanceTheme(dark)
+	}
+	if dark, ok := darkModeFromPortal(); ok {
+		return m.setAppearanceTheme(dark)
+	}
+	return false
+}
+
+func (m *Manager) setAppearanceTheme(dark bool) bool {
+	if dark {
+		return m.SetTheme("dark")
+	}
+	return m.SetTheme("light")
+}
+
+// darkModeFromEnv reads $DARK_MODE as a boolean, e.g. "1"/"true" for dark
+// and "0"/"false" for light.
+func darkModeFromEnv() (dark bool, ok bool) {
+	value := os.Getenv("DARK_MODE")
+	if value == "" {
+		return false, false
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, false
+	}
+	return parsed, true
+}
+
+// darkModeFromMacOS asks macOS for its global interface style. The key is
+// only ever set to "Dark"; its absence means light mode.
+func darkModeFromMacOS() (dark bool, ok bool) {
+	if runtime.GOOS != "darwin" {
+		return false, false
+	}
+
+	out, err := exec.Command("defaults", "read", "-g", "AppleInterfaceStyle").Output()
+	if err != nil {
+		// A non-zero exit here means the key is unset, i.e. light mode.
+		return false, true
+	}
+	return strings.EqualFold(strings.TrimSpace(string(out)), "Dark"), true
+}
+
+// darkModeFromPortal asks the freedesktop Settings portal for
+// org.freedesktop.appearance color-scheme (0 = no preference, 1 = prefer
+// dark, 2 = prefer light), the mechanism GNOME and KDE expose to apps that
+// aren't full desktop-environment citizens.
+func darkModeFromPortal() (dark bool, ok bool) {
+	out, err := exec.Command(
+		"gdbus", "call", "--session",
+		"--dest", "org.freedesktop.portal.Desktop",
+		"--object-path", "/org/freedesktop/portal/desktop",
+		"--method", "org.freedesktop.portal.Settings.Read",
+		"org.freedesktop.appearance", "color-scheme",
+	).Output()
+	if err != nil {
+		return false, false
+	}
+
+	switch {
+	case strings.Contains(string(out), "uint32 1"):
+		return true, true
+	case strings.Contains(string(out), "uint32 2"):
+		return false, true
+	default:
+		return false, false
+	}
+}
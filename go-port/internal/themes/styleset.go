@@ -0,0 +1,391 @@
+package themes
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// styleSelector holds the colors and attributes a styleset file assigned to
+// one object selector (e.g. "editor.text"). A nil fg/bg means the selector
+// didn't set it and the theme's plain default should be used instead.
+type styleSelector struct {
+	fg   *tcell.Color
+	bg   *tcell.Color
+	attr tcell.AttrMask
+}
+
+// StyleSetTheme is a Theme loaded from a styleset file. Anything the file
+// doesn't define for a given selector falls back to BaseTheme's defaults.
+type StyleSetTheme struct {
+	*BaseTheme
+	selectors map[string]styleSelector
+}
+
+// LoadStyleset loads a theme from a plain-text styleset file such as
+// ~/.config/writers-cli/stylesets/solarized.ini. The format is INI-like:
+//
+//	[palette]
+//	accent=#ff8800
+//
+//	[editor.text]
+//	fg=#839496
+//	bg=#002b36
+//	attr=bold
+//
+// Recognized selectors are editor.text, editor.border, editor.title,
+// status.bar, info.bar, help.bar, linenumber, selection, search.match,
+// error, and typewriter.focus. A "[*]" section supplies fg/bg/attr
+// fallbacks for any selector that leaves them unset. Color values are
+// resolved, in order, as a name from [palette], a "#rrggbb" hex code, or
+// one of tcell's named colors (which cover the 16 ANSI names). attr accepts
+// a comma-separated list of bold, underline, reverse, italic, and dim.
+//
+// The theme is registered under the file's base name with the extension
+// stripped (solarized.ini -> "solarized").
+func (m *Manager) LoadStyleset(path string) error {
+	palette, sections, err := parseStyleset(path)
+	if err != nil {
+		return fmt.Errorf("failed to read styleset %s: %w", path, err)
+	}
+
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if name == "" {
+		return fmt.Errorf("styleset %s has no usable name", path)
+	}
+
+	fallback := buildSelector(sections["*"], palette)
+	selectors := make(map[string]styleSelector, len(sections))
+	for section, kv := range sections {
+		if section == "palette" || section == "*" {
+			continue
+		}
+		selectors[section] = withFallback(buildSelector(kv, palette), fallback)
+	}
+
+	theme := &StyleSetTheme{
+		BaseTheme: NewBaseTheme(name, displayNameFor(name), isDarkStyleset(selectors)),
+		selectors: selectors,
+	}
+
+	return m.RegisterTheme(theme)
+}
+
+// LoadStylesetsDir registers every ".ini" styleset file found directly in
+// dir, returning one error per file that failed to parse. A missing dir
+// isn't an error - it just means no stylesets are installed yet.
+func (m *Manager) LoadStylesetsDir(dir string) []error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	var errs []error
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".ini" {
+			continue
+		}
+		if err := m.LoadStyleset(filepath.Join(dir, entry.Name())); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// parseStyleset reads path into its [palette] entries and the key/value
+// pairs of every other section, in the order tolerant of blank lines and
+// ";"/"#" comments.
+func parseStyleset(path string) (palette map[string]string, sections map[string]map[string]string, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	palette = make(map[string]string)
+	sections = make(map[string]map[string]string)
+
+	var current string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, ";") || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			current = strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := sections[current]; !ok {
+				sections[current] = make(map[string]string)
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || current == "" {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		if current == "palette" {
+			palette[key] = value
+		} else {
+			sections[current][key] = value
+		}
+	}
+
+	return palette, sections, scanner.Err()
+}
+
+// resolveColor resolves value as a styleset color: a [palette] name, a
+// "#rrggbb" hex code, or one of tcell's named colors.
+func resolveColor(value string, palette map[string]string) (tcell.Color, bool) {
+	if resolved, ok := palette[value]; ok {
+		value = resolved
+	}
+
+	color := tcell.GetColor(value)
+	if color == tcell.ColorDefault && !strings.EqualFold(value, "default") {
+		return 0, false
+	}
+	return color, true
+}
+
+// buildSelector resolves one section's fg/bg/attr entries against palette.
+func buildSelector(kv map[string]string, palette map[string]string) styleSelector {
+	var sel styleSelector
+	if v, ok := kv["fg"]; ok {
+		if c, ok := resolveColor(v, palette); ok {
+			sel.fg = &c
+		}
+	}
+	if v, ok := kv["bg"]; ok {
+		if c, ok := resolveColor(v, palette); ok {
+			sel.bg = &c
+		}
+	}
+	if v, ok := kv["attr"]; ok {
+		sel.attr = parseAttr(v)
+	}
+	return sel
+}
+
+// withFallback fills in any of sel's unset fg/bg/attr from fallback (the
+// "[*]" section).
+func withFallback(sel, fallback styleSelector) styleSelector {
+	if sel.fg == nil {
+		sel.fg = fallback.fg
+	}
+	if sel.bg == nil {
+		sel.bg = fallback.bg
+	}
+	if sel.attr == 0 {
+		sel.attr = fallback.attr
+	}
+	return sel
+}
+
+// parseAttr turns a comma-separated attr value ("bold,underline") into a
+// tcell.AttrMask, ignoring anything it doesn't recognize.
+func parseAttr(value string) tcell.AttrMask {
+	var mask tcell.AttrMask
+	for _, part := range strings.Split(value, ",") {
+		switch strings.TrimSpace(strings.ToLower(part)) {
+		case "bold":
+			mask |= tcell.AttrBold
+		case "underline":
+			mask |= tcell.AttrUnderline
+		case "reverse":
+			mask |= tcell.AttrReverse
+		case "italic":
+			mask |= tcell.AttrItalic
+		case "dim":
+			mask |= tcell.AttrDim
+		}
+	}
+	return mask
+}
+
+// displayNameFor turns a styleset's base name ("solarized") into a display
+// name ("Solarized Theme").
+func displayNameFor(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:] + " Theme"
+}
+
+// isDarkStyleset guesses whether a styleset is a dark theme from the
+// perceived brightness of editor.text's background.
+func isDarkStyleset(selectors map[string]styleSelector) bool {
+	sel, ok := selectors["editor.text"]
+	if !ok || sel.bg == nil {
+		return true
+	}
+	r, g, b := sel.bg.RGB()
+	luminance := (299*r + 587*g + 114*b) / 1000
+	return luminance < 128
+}
+
+// selector looks up a selector's resolved style, reporting false if the
+// styleset never defined that selector at all.
+func (t *StyleSetTheme) selector(name string) (styleSelector, bool) {
+	sel, ok := t.selectors[name]
+	return sel, ok
+}
+
+func (t *StyleSetTheme) GetBackgroundColor() tcell.Color {
+	if sel, ok := t.selector("editor.text"); ok && sel.bg != nil {
+		return *sel.bg
+	}
+	return t.BaseTheme.GetBackgroundColor()
+}
+
+func (t *StyleSetTheme) GetForegroundColor() tcell.Color {
+	if sel, ok := t.selector("editor.text"); ok && sel.fg != nil {
+		return *sel.fg
+	}
+	return t.BaseTheme.GetForegroundColor()
+}
+
+func (t *StyleSetTheme) GetBorderColor() tcell.Color {
+	if sel, ok := t.selector("editor.border"); ok && sel.fg != nil {
+		return *sel.fg
+	}
+	return t.BaseTheme.GetBorderColor()
+}
+
+// GetBorderFocusColor reuses editor.border's color; the format has no
+// separate selector for a pane's focused-border state.
+func (t *StyleSetTheme) GetBorderFocusColor() tcell.Color {
+	return t.GetBorderColor()
+}
+
+func (t *StyleSetTheme) GetTitleColor() tcell.Color {
+	if sel, ok := t.selector("editor.title"); ok && sel.fg != nil {
+		return *sel.fg
+	}
+	return t.BaseTheme.GetTitleColor()
+}
+
+func (t *StyleSetTheme) GetStatusBgColor() tcell.Color {
+	if sel, ok := t.selector("status.bar"); ok && sel.bg != nil {
+		return *sel.bg
+	}
+	return t.BaseTheme.GetStatusBgColor()
+}
+
+func (t *StyleSetTheme) GetStatusFgColor() tcell.Color {
+	if sel, ok := t.selector("status.bar"); ok && sel.fg != nil {
+		return *sel.fg
+	}
+	return t.BaseTheme.GetStatusFgColor()
+}
+
+func (t *StyleSetTheme) GetInfoBgColor() tcell.Color {
+	if sel, ok := t.selector("info.bar"); ok && sel.bg != nil {
+		return *sel.bg
+	}
+	return t.BaseTheme.GetInfoBgColor()
+}
+
+func (t *StyleSetTheme) GetInfoFgColor() tcell.Color {
+	if sel, ok := t.selector("info.bar"); ok && sel.fg != nil {
+		return *sel.fg
+	}
+	return t.BaseTheme.GetInfoFgColor()
+}
+
+func (t *StyleSetTheme) GetHelpBgColor() tcell.Color {
+	if sel, ok := t.selector("help.bar"); ok && sel.bg != nil {
+		return *sel.bg
+	}
+	return t.BaseTheme.GetHelpBgColor()
+}
+
+func (t *StyleSetTheme) GetHelpFgColor() tcell.Color {
+	if sel, ok := t.selector("help.bar"); ok && sel.fg != nil {
+		return *sel.fg
+	}
+	return t.BaseTheme.GetHelpFgColor()
+}
+
+func (t *StyleSetTheme) GetLineNumberBgColor() tcell.Color {
+	if sel, ok := t.selector("linenumber"); ok && sel.bg != nil {
+		return *sel.bg
+	}
+	return t.BaseTheme.GetLineNumberBgColor()
+}
+
+func (t *StyleSetTheme) GetLineNumberFgColor() tcell.Color {
+	if sel, ok := t.selector("linenumber"); ok && sel.fg != nil {
+		return *sel.fg
+	}
+	return t.BaseTheme.GetLineNumberFgColor()
+}
+
+func (t *StyleSetTheme) GetLineNumberActiveFgColor() tcell.Color {
+	return t.GetLineNumberFgColor()
+}
+
+func (t *StyleSetTheme) GetSelectionBgColor() tcell.Color {
+	if sel, ok := t.selector("selection"); ok && sel.bg != nil {
+		return *sel.bg
+	}
+	return t.BaseTheme.GetSelectionBgColor()
+}
+
+func (t *StyleSetTheme) GetSelectionFgColor() tcell.Color {
+	if sel, ok := t.selector("selection"); ok && sel.fg != nil {
+		return *sel.fg
+	}
+	return t.BaseTheme.GetSelectionFgColor()
+}
+
+func (t *StyleSetTheme) GetErrorColor() tcell.Color {
+	if sel, ok := t.selector("error"); ok && sel.fg != nil {
+		return *sel.fg
+	}
+	return t.BaseTheme.GetErrorColor()
+}
+
+func (t *StyleSetTheme) GetErrorColorCode() string {
+	if sel, ok := t.selector("error"); ok && sel.fg != nil {
+		return hexCode(*sel.fg)
+	}
+	return t.BaseTheme.GetErrorColorCode()
+}
+
+// GetInfoColor and GetInfoColorCode draw from search.match, since that's
+// the selector the command palette's fuzzy-match highlighting uses.
+func (t *StyleSetTheme) GetInfoColor() tcell.Color {
+	if sel, ok := t.selector("search.match"); ok && sel.fg != nil {
+		return *sel.fg
+	}
+	return t.BaseTheme.GetInfoColor()
+}
+
+func (t *StyleSetTheme) GetInfoColorCode() string {
+	if sel, ok := t.selector("search.match"); ok && sel.fg != nil {
+		return hexCode(*sel.fg)
+	}
+	return t.BaseTheme.GetInfoColorCode()
+}
+
+// GetDimmedColor draws from typewriter.focus, matching the other themes'
+// use of the dimmed color for typewriter mode.
+func (t *StyleSetTheme) GetDimmedColor() tcell.Color {
+	if sel, ok := t.selector("typewriter.focus"); ok && sel.fg != nil {
+		return *sel.fg
+	}
+	return t.BaseTheme.GetDimmedColor()
+}
+
+// hexCode renders color as a "#rrggbb" tview markup color code.
+func hexCode(color tcell.Color) string {
+	r, g, b := color.RGB()
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
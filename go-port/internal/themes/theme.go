@@ -60,9 +60,20 @@ type Theme interface {
 	GetWarningColorCode() string
 	GetInfoColorCode() string
 
-	// Syntax highlighting colors
+	// Syntax highlighting colors. Not yet rendered anywhere - see
+	// SyntaxColors' doc comment.
 	GetSyntaxColors() SyntaxColors
 
+	// Prose-specific styling (dialogue, screenplay, diagnostics). Not yet
+	// rendered anywhere - see ProseColors' doc comment.
+	GetProseColors() ProseColors
+
+	// Status/info/help bar text attributes (bold, underline, etc.),
+	// alongside their existing Bg/Fg colors above
+	GetStatusAttrs() tcell.AttrMask
+	GetInfoAttrs() tcell.AttrMask
+	GetHelpAttrs() tcell.AttrMask
+
 	// Welcome message
 	GetWelcomeMessage() string
 
@@ -70,25 +81,94 @@ type Theme interface {
 	Validate() bool
 }
 
-// SyntaxColors holds colors for syntax highlighting
+// StyledColor is a foreground color plus the text attributes (bold, dim,
+// underline, italic, reverse, blink) it's rendered with - tcell.Style
+// without a background, since syntax roles only ever color text on the
+// theme's existing background.
+//
+// Style is ready to pass to a tview widget's SetStyle, but the editor's own
+// panes render into *tview.TextArea, whose own doc comment says plainly:
+// "Multi-color text is not supported." Nothing in internal/editor calls
+// Style or reads a StyledColor's Attrs today, because there is no per-token
+// rendering path for it to feed - that needs either an upstream TextArea
+// change or swapping the editing widget for something like a custom
+// TextView-based renderer, not a change this package can make on its own.
+type StyledColor struct {
+	Fg    tcell.Color
+	Attrs tcell.AttrMask
+}
+
+// Style returns s as a tcell.Style against the given background, ready to
+// pass to SetStyle.
+func (s StyledColor) Style(bg tcell.Color) tcell.Style {
+	return tcell.StyleDefault.Foreground(s.Fg).Background(bg).Attributes(s.Attrs)
+}
+
+// SyntaxColors holds the styling for syntax highlighting. See StyledColor's
+// doc comment for why nothing in internal/editor consumes this yet.
 type SyntaxColors struct {
-	Heading     tcell.Color
-	Emphasis    tcell.Color
-	Strong      tcell.Color
-	Link        tcell.Color
-	Code        tcell.Color
-	CodeBlock   tcell.Color
-	Quote       tcell.Color
-	List        tcell.Color
-	Keyword     tcell.Color
-	String      tcell.Color
-	Comment     tcell.Color
-	Number      tcell.Color
-	Operator    tcell.Color
-	Punctuation tcell.Color
-	Variable    tcell.Color
-	Function    tcell.Color
-	Type        tcell.Color
+	Heading     StyledColor
+	Emphasis    StyledColor
+	Strong      StyledColor
+	Link        StyledColor
+	Code        StyledColor
+	CodeBlock   StyledColor
+	Quote       StyledColor
+	List        StyledColor
+	Keyword     StyledColor
+	String      StyledColor
+	Comment     StyledColor
+	Number      StyledColor
+	Operator    StyledColor
+	Punctuation StyledColor
+	Variable    StyledColor
+	Function    StyledColor
+	Type        StyledColor
+
+	// Fountain screenplay element colors
+	SceneHeading  StyledColor
+	Character     StyledColor
+	Parenthetical StyledColor
+	Dialogue      StyledColor
+	Transition    StyledColor
+	Note          StyledColor
+	Boneyard      StyledColor
+}
+
+// ProseColors holds the styling for roles a novel/screenplay editor needs
+// beyond plain SyntaxColors: dialogue and screenplay formatting, inline
+// spell/grammar diagnostics, and the writing-focused typewriter/hemingway
+// and word-count-milestone modes.
+//
+// Like SyntaxColors, nothing outside the themes package reads this yet.
+// internal/editor's panes are *tview.TextArea, which can't render multi-
+// color text at all, so none of these per-role colors (dialogue quotes,
+// spell/grammar underlines, the focused-sentence highlight) can actually
+// reach the screen until the editor renders through a widget that supports
+// it.
+type ProseColors struct {
+	// Markdown-as-manuscript conventions
+	DialogueQuote StyledColor // quoted dialogue inside narrative prose
+	Footnote      StyledColor // footnote markers and their text
+
+	// Screenplay (Fountain) elements beyond SyntaxColors' own
+	// SceneHeading/Character, which this mirrors for non-Fountain editors
+	CharacterName  StyledColor
+	SceneHeading   StyledColor
+	StageDirection StyledColor
+
+	// Writing-progress feedback
+	WordCountMilestone StyledColor // goal-reached celebration text
+
+	// Inline diagnostics from a future spell/grammar subsystem
+	SpellError        StyledColor
+	GrammarWarning    StyledColor
+	ReadabilityEasy   StyledColor
+	ReadabilityMedium StyledColor
+	ReadabilityHard   StyledColor
+
+	// Typewriter/Hemingway mode: the sentence under the cursor
+	FocusedSentence StyledColor
 }
 
 // BaseTheme provides a foundation for all themes
@@ -238,26 +318,70 @@ func (t *BaseTheme) GetInfoColorCode() string {
 
 func (t *BaseTheme) GetSyntaxColors() SyntaxColors {
 	return SyntaxColors{
-		Heading:     tcell.ColorBlue,
-		Emphasis:    tcell.ColorRed,
-		Strong:      tcell.ColorMaroon,
-		Link:        tcell.ColorBlue,
-		Code:        tcell.ColorPurple,
-		CodeBlock:   tcell.ColorRed,
-		Quote:       tcell.ColorGreen,
-		List:        tcell.ColorPurple,
-		Keyword:     tcell.ColorBlue,
-		String:      tcell.ColorRed,
-		Comment:     tcell.ColorGreen,
-		Number:      tcell.ColorTeal,
-		Operator:    tcell.ColorBlack,
-		Punctuation: tcell.ColorGray,
-		Variable:    tcell.ColorBlue,
-		Function:    tcell.ColorYellow,
-		Type:        tcell.ColorTeal,
+		Heading:     StyledColor{Fg: tcell.ColorBlue, Attrs: tcell.AttrBold},
+		Emphasis:    StyledColor{Fg: tcell.ColorRed, Attrs: tcell.AttrItalic},
+		Strong:      StyledColor{Fg: tcell.ColorMaroon, Attrs: tcell.AttrBold},
+		Link:        StyledColor{Fg: tcell.ColorBlue, Attrs: tcell.AttrUnderline},
+		Code:        StyledColor{Fg: tcell.ColorPurple},
+		CodeBlock:   StyledColor{Fg: tcell.ColorRed},
+		Quote:       StyledColor{Fg: tcell.ColorGreen, Attrs: tcell.AttrItalic},
+		List:        StyledColor{Fg: tcell.ColorPurple},
+		Keyword:     StyledColor{Fg: tcell.ColorBlue, Attrs: tcell.AttrBold},
+		String:      StyledColor{Fg: tcell.ColorRed},
+		Comment:     StyledColor{Fg: tcell.ColorGreen, Attrs: tcell.AttrDim},
+		Number:      StyledColor{Fg: tcell.ColorTeal},
+		Operator:    StyledColor{Fg: tcell.ColorBlack},
+		Punctuation: StyledColor{Fg: tcell.ColorGray},
+		Variable:    StyledColor{Fg: tcell.ColorBlue},
+		Function:    StyledColor{Fg: tcell.ColorYellow},
+		Type:        StyledColor{Fg: tcell.ColorTeal},
+
+		SceneHeading:  StyledColor{Fg: tcell.ColorBlue, Attrs: tcell.AttrBold},
+		Character:     StyledColor{Fg: tcell.ColorMaroon, Attrs: tcell.AttrBold},
+		Parenthetical: StyledColor{Fg: tcell.ColorGray, Attrs: tcell.AttrItalic},
+		Dialogue:      StyledColor{Fg: tcell.ColorBlack},
+		Transition:    StyledColor{Fg: tcell.ColorPurple},
+		Note:          StyledColor{Fg: tcell.ColorGreen, Attrs: tcell.AttrItalic},
+		Boneyard:      StyledColor{Fg: tcell.ColorGray, Attrs: tcell.AttrDim},
 	}
 }
 
+// GetProseColors returns the base theme's prose-specific styling, reusing
+// its syntax roles where they already line up (quotes look like strings,
+// a milestone looks like a success message) and falling back to the plain
+// foreground/dimmed colors elsewhere.
+func (t *BaseTheme) GetProseColors() ProseColors {
+	syntax := t.GetSyntaxColors()
+	return ProseColors{
+		DialogueQuote:      syntax.String,
+		Footnote:           StyledColor{Fg: t.GetDimmedColor(), Attrs: tcell.AttrItalic},
+		CharacterName:      syntax.Character,
+		SceneHeading:       syntax.SceneHeading,
+		StageDirection:     syntax.Parenthetical,
+		WordCountMilestone: StyledColor{Fg: t.GetSuccessColor(), Attrs: tcell.AttrBold},
+		SpellError:         StyledColor{Fg: t.GetErrorColor(), Attrs: tcell.AttrUnderline},
+		GrammarWarning:     StyledColor{Fg: t.GetWarningColor(), Attrs: tcell.AttrUnderline},
+		ReadabilityEasy:    StyledColor{Fg: t.GetSuccessColor()},
+		ReadabilityMedium:  StyledColor{Fg: t.GetWarningColor()},
+		ReadabilityHard:    StyledColor{Fg: t.GetErrorColor()},
+		FocusedSentence:    StyledColor{Fg: t.GetForegroundColor(), Attrs: tcell.AttrBold},
+	}
+}
+
+// GetStatusAttrs, GetInfoAttrs, and GetHelpAttrs default to no attributes;
+// themes that want their bars bold or underlined override them.
+func (t *BaseTheme) GetStatusAttrs() tcell.AttrMask {
+	return tcell.AttrNone
+}
+
+func (t *BaseTheme) GetInfoAttrs() tcell.AttrMask {
+	return tcell.AttrNone
+}
+
+func (t *BaseTheme) GetHelpAttrs() tcell.AttrMask {
+	return tcell.AttrNone
+}
+
 func (t *BaseTheme) GetWelcomeMessage() string {
 	return "Theme: " + t.displayName
 }
@@ -0,0 +1,264 @@
+package themes
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// vimColorschemeTheme is a Theme built from a Vim/Neovim colorscheme's "hi"
+// (highlight) lines, mapping each Theme role onto the Vim highlight group
+// that conventionally carries it: Normal for the editor background,
+// Comment for dimmed text, StatusLine for the status bar, and so on. A
+// role whose group the file never defines falls back to BaseTheme.
+type vimColorschemeTheme struct {
+	*BaseTheme
+	groups map[string]groupStyle
+}
+
+// LoadVimColorscheme parses a Vim/Neovim colorscheme file's
+//
+//	hi Group guifg=#rrggbb guibg=#rrggbb gui=bold,italic,...
+//	hi link Group OtherGroup
+//
+// lines into a Theme, giving writers-cli instant access to the thousands
+// of existing Vim colorschemes. cterm-only colors (ctermfg/ctermbg) are
+// ignored; only the gui* keys, which already carry 24-bit hex, are used.
+func LoadVimColorscheme(path string) (Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vim colorscheme %s: %w", path, err)
+	}
+
+	groups, err := parseVimHighlights(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vim colorscheme %s: %w", path, err)
+	}
+
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return &vimColorschemeTheme{
+		BaseTheme: NewBaseTheme(name, displayNameFor(name), true),
+		groups:    groups,
+	}, nil
+}
+
+// parseVimHighlights scans data for "hi"/"highlight" lines, resolving
+// "hi link Group Target" aliases against the groups already seen.
+func parseVimHighlights(data string) (map[string]groupStyle, error) {
+	groups := make(map[string]groupStyle)
+	links := make(map[string]string)
+
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || (fields[0] != "hi" && fields[0] != "highlight") {
+			continue
+		}
+		fields = fields[1:]
+		if fields[0] == "default" && len(fields) > 1 {
+			fields = fields[1:]
+		}
+
+		if fields[0] == "link" {
+			if len(fields) >= 3 {
+				links[fields[1]] = fields[2]
+			}
+			continue
+		}
+
+		group := fields[0]
+		style := groupStyle{}
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+			switch key {
+			case "guifg":
+				if c, ok := resolveFileColor(value); ok {
+					style.fg, style.hasFg = c, true
+				}
+			case "guibg":
+				if c, ok := resolveFileColor(value); ok {
+					style.bg, style.hasBg = c, true
+				}
+			case "gui":
+				for _, attrName := range strings.Split(value, ",") {
+					if attr, ok := styleAttrNames[strings.ToLower(attrName)]; ok {
+						style.attrs |= attr
+					}
+				}
+			}
+		}
+		groups[group] = style
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for alias, target := range links {
+		if style, ok := groups[target]; ok {
+			groups[alias] = style
+		}
+	}
+
+	return groups, nil
+}
+
+func (t *vimColorschemeTheme) GetBackgroundColor() tcell.Color {
+	return groupBg(t.groups, "Normal", t.BaseTheme.GetBackgroundColor())
+}
+
+func (t *vimColorschemeTheme) GetForegroundColor() tcell.Color {
+	return groupFg(t.groups, "Normal", t.BaseTheme.GetForegroundColor())
+}
+
+func (t *vimColorschemeTheme) GetBorderColor() tcell.Color {
+	return groupEither(t.groups, "VertSplit", t.BaseTheme.GetBorderColor())
+}
+
+func (t *vimColorschemeTheme) GetBorderFocusColor() tcell.Color {
+	return groupFg(t.groups, "Title", t.BaseTheme.GetBorderFocusColor())
+}
+
+func (t *vimColorschemeTheme) GetTitleColor() tcell.Color {
+	return groupFg(t.groups, "Title", t.BaseTheme.GetTitleColor())
+}
+
+func (t *vimColorschemeTheme) GetStatusBgColor() tcell.Color {
+	return groupBg(t.groups, "StatusLine", t.BaseTheme.GetStatusBgColor())
+}
+
+func (t *vimColorschemeTheme) GetStatusFgColor() tcell.Color {
+	return groupFg(t.groups, "StatusLine", t.BaseTheme.GetStatusFgColor())
+}
+
+func (t *vimColorschemeTheme) GetInfoBgColor() tcell.Color {
+	return groupBg(t.groups, "Pmenu", t.BaseTheme.GetInfoBgColor())
+}
+
+func (t *vimColorschemeTheme) GetInfoFgColor() tcell.Color {
+	return groupFg(t.groups, "Pmenu", t.BaseTheme.GetInfoFgColor())
+}
+
+func (t *vimColorschemeTheme) GetHelpBgColor() tcell.Color {
+	return groupBg(t.groups, "StatusLineNC", t.BaseTheme.GetHelpBgColor())
+}
+
+func (t *vimColorschemeTheme) GetHelpFgColor() tcell.Color {
+	return groupFg(t.groups, "StatusLineNC", t.BaseTheme.GetHelpFgColor())
+}
+
+func (t *vimColorschemeTheme) GetLineNumberBgColor() tcell.Color {
+	return groupBg(t.groups, "LineNr", t.BaseTheme.GetLineNumberBgColor())
+}
+
+func (t *vimColorschemeTheme) GetLineNumberFgColor() tcell.Color {
+	return groupFg(t.groups, "LineNr", t.BaseTheme.GetLineNumberFgColor())
+}
+
+func (t *vimColorschemeTheme) GetLineNumberActiveFgColor() tcell.Color {
+	return groupFg(t.groups, "CursorLineNr", t.BaseTheme.GetLineNumberActiveFgColor())
+}
+
+func (t *vimColorschemeTheme) GetSelectionBgColor() tcell.Color {
+	return groupBg(t.groups, "Visual", t.BaseTheme.GetSelectionBgColor())
+}
+
+func (t *vimColorschemeTheme) GetSelectionFgColor() tcell.Color {
+	return groupFg(t.groups, "Visual", t.BaseTheme.GetSelectionFgColor())
+}
+
+func (t *vimColorschemeTheme) GetCursorColor() tcell.Color {
+	return groupEither(t.groups, "Cursor", t.BaseTheme.GetCursorColor())
+}
+
+func (t *vimColorschemeTheme) GetCursorInsertColor() tcell.Color {
+	return groupEither(t.groups, "Cursor", t.BaseTheme.GetCursorInsertColor())
+}
+
+func (t *vimColorschemeTheme) GetSuccessColor() tcell.Color {
+	return groupEither(t.groups, "DiffAdd", t.BaseTheme.GetSuccessColor())
+}
+
+func (t *vimColorschemeTheme) GetWarningColor() tcell.Color {
+	return groupEither(t.groups, "WarningMsg", t.BaseTheme.GetWarningColor())
+}
+
+func (t *vimColorschemeTheme) GetErrorColor() tcell.Color {
+	return groupEither(t.groups, "ErrorMsg", t.BaseTheme.GetErrorColor())
+}
+
+func (t *vimColorschemeTheme) GetInfoColor() tcell.Color {
+	return groupFg(t.groups, "Directory", t.BaseTheme.GetInfoColor())
+}
+
+func (t *vimColorschemeTheme) GetDimmedColor() tcell.Color {
+	return groupFg(t.groups, "Comment", t.BaseTheme.GetDimmedColor())
+}
+
+func (t *vimColorschemeTheme) GetErrorColorCode() string {
+	return hexCode(t.GetErrorColor())
+}
+
+func (t *vimColorschemeTheme) GetSuccessColorCode() string {
+	return hexCode(t.GetSuccessColor())
+}
+
+func (t *vimColorschemeTheme) GetWarningColorCode() string {
+	return hexCode(t.GetWarningColor())
+}
+
+func (t *vimColorschemeTheme) GetInfoColorCode() string {
+	return hexCode(t.GetInfoColor())
+}
+
+func (t *vimColorschemeTheme) GetSyntaxColors() SyntaxColors {
+	base := t.BaseTheme.GetSyntaxColors()
+	return SyntaxColors{
+		Heading:       groupStyled(t.groups, "Title", base.Heading),
+		Emphasis:      groupStyled(t.groups, "Italic", base.Emphasis),
+		Strong:        groupStyled(t.groups, "Bold", base.Strong),
+		Link:          groupStyled(t.groups, "Underlined", base.Link),
+		Code:          groupStyled(t.groups, "String", base.Code),
+		CodeBlock:     groupStyled(t.groups, "String", base.CodeBlock),
+		Quote:         groupStyled(t.groups, "Comment", base.Quote),
+		List:          groupStyled(t.groups, "Special", base.List),
+		Keyword:       groupStyled(t.groups, "Statement", base.Keyword),
+		String:        groupStyled(t.groups, "String", base.String),
+		Comment:       groupStyled(t.groups, "Comment", base.Comment),
+		Number:        groupStyled(t.groups, "Number", base.Number),
+		Operator:      groupStyled(t.groups, "Operator", base.Operator),
+		Punctuation:   groupStyled(t.groups, "Delimiter", base.Punctuation),
+		Variable:      groupStyled(t.groups, "Identifier", base.Variable),
+		Function:      groupStyled(t.groups, "Function", base.Function),
+		Type:          groupStyled(t.groups, "Type", base.Type),
+		SceneHeading:  groupStyled(t.groups, "Title", base.SceneHeading),
+		Character:     groupStyled(t.groups, "Identifier", base.Character),
+		Parenthetical: groupStyled(t.groups, "Comment", base.Parenthetical),
+		Dialogue:      groupStyled(t.groups, "Normal", base.Dialogue),
+		Transition:    groupStyled(t.groups, "Special", base.Transition),
+		Note:          groupStyled(t.groups, "Todo", base.Note),
+		Boneyard:      groupStyled(t.groups, "NonText", base.Boneyard),
+	}
+}
+
+func (t *vimColorschemeTheme) GetStatusAttrs() tcell.AttrMask {
+	return t.groups["StatusLine"].attrs
+}
+
+func (t *vimColorschemeTheme) GetInfoAttrs() tcell.AttrMask {
+	return t.groups["Pmenu"].attrs
+}
+
+func (t *vimColorschemeTheme) GetHelpAttrs() tcell.AttrMask {
+	return t.groups["StatusLineNC"].attrs
+}
+
+func (t *vimColorschemeTheme) GetWelcomeMessage() string {
+	return "Theme: " + t.GetDisplayName() + " (imported from Vim)"
+}
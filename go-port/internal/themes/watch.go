@@ -0,0 +1,107 @@
+package themes
+
+import (
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// WatchDir watches dir for theme file changes and keeps r in sync: on every
+// create/write of a ".toml"/".yaml"/".yml"/".json" file, it reloads that
+// file and re-registers the theme. If that theme is the active one, it is
+// re-applied so an open editor picks up the edit immediately, and the
+// reloaded Theme is sent on changed so a caller holding an open UI knows to
+// repaint; reloading any other theme sends nothing. changed is never closed
+// and sends are non-blocking,
+// so a caller that isn't currently receiving just misses that one
+// notification rather than blocking the watcher. It runs until stop is
+// called; errors from fsnotify itself (not individual file reloads, which
+// are swallowed the same way LoadThemesDir swallows them) are sent to errs,
+// which the caller should drain to avoid leaking the watcher goroutine.
+func (r *ThemeRegistry) WatchDir(dir string) (stop func(), changed <-chan Theme, errs <-chan error, err error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := watcher.Add(dir); err != nil {
+		watcher.Close()
+		return nil, nil, nil, err
+	}
+
+	changedCh := make(chan Theme)
+	errCh := make(chan error)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(errCh)
+		for {
+			select {
+			case <-done:
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if !isThemeFile(event.Name) {
+					continue
+				}
+				if event.Op&(fsnotify.Write|fsnotify.Create) != 0 {
+					if theme := r.reloadTheme(event.Name); theme != nil {
+						select {
+						case changedCh <- theme:
+						case <-done:
+							return
+						default:
+						}
+					}
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				select {
+				case errCh <- err:
+				case <-done:
+					return
+				}
+			}
+		}
+	}()
+
+	stop = func() {
+		close(done)
+		watcher.Close()
+	}
+	return stop, changedCh, errCh, nil
+}
+
+// reloadTheme re-reads path and, if it parses, registers the theme. If it's
+// also the theme currently in use, it re-applies it as the active theme and
+// returns it, so an edit to the active theme's own file takes effect live;
+// otherwise it returns nil.
+func (r *ThemeRegistry) reloadTheme(path string) Theme {
+	theme, err := LoadFromFile(path)
+	if err != nil {
+		return nil
+	}
+	if err := r.RegisterTheme(theme); err != nil {
+		return nil
+	}
+
+	if current := r.GetCurrentTheme(); current != nil && current.GetName() == theme.GetName() {
+		r.SetTheme(theme.GetName())
+		return theme
+	}
+	return nil
+}
+
+// isThemeFile reports whether path has one of the extensions LoadFromDir
+// recognizes.
+func isThemeFile(path string) bool {
+	lower := strings.ToLower(path)
+	return strings.HasSuffix(lower, ".toml") ||
+		strings.HasSuffix(lower, ".yaml") ||
+		strings.HasSuffix(lower, ".yml") ||
+		strings.HasSuffix(lower, ".json")
+}
@@ -0,0 +1,186 @@
+// Package vcs wraps go-git for the git operations writers-cli needs: a
+// real "git init" plus initial commit for new projects, auto-committing a
+// manuscript as the writer saves, and browsing a chapter's revision
+// history from within the editor.
+package vcs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// Author identifies who a commit is attributed to.
+type Author struct {
+	Name  string
+	Email string
+}
+
+// Commit is one revision of a file, as returned by History.
+type Commit struct {
+	Hash    string
+	Author  string
+	Email   string
+	When    time.Time
+	Message string
+}
+
+// Init creates a real git repository at projectPath and commits every
+// scaffolded file already there as the initial commit.
+func Init(projectPath string, author Author, message string) error {
+	repo, err := git.PlainInit(projectPath, false)
+	if err != nil {
+		return fmt.Errorf("failed to init repository: %w", err)
+	}
+	return commitAll(repo, author, message)
+}
+
+// Snapshot commits every changed file in the repository containing dir,
+// authored by author. dir need not be the repository root — the enclosing
+// .git directory is located automatically, so the editor can pass the
+// saved file's own directory. Snapshot is a no-op if nothing changed, so
+// the editor can call it unconditionally whenever Settings.AutoCommit is
+// on and a file is saved.
+func Snapshot(dir string, author Author, message string) error {
+	repo, err := git.PlainOpenWithOptions(dir, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return fmt.Errorf("failed to open repository: %w", err)
+	}
+	return commitAll(repo, author, message)
+}
+
+// commitAll stages every change in repo's worktree and commits it, unless
+// the worktree is already clean.
+func commitAll(repo *git.Repository, author Author, message string) error {
+	wt, err := repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("failed to open worktree: %w", err)
+	}
+
+	if err := wt.AddWithOptions(&git.AddOptions{All: true}); err != nil {
+		return fmt.Errorf("failed to stage changes: %w", err)
+	}
+
+	status, err := wt.Status()
+	if err != nil {
+		return fmt.Errorf("failed to read worktree status: %w", err)
+	}
+	if status.IsClean() {
+		return nil
+	}
+
+	_, err = wt.Commit(message, &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  author.Name,
+			Email: author.Email,
+			When:  time.Now(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+	return nil
+}
+
+// Root returns the root directory of the repository containing dir, so
+// callers that only know a file's own directory can compute the file's
+// path relative to the repository root for History and Diff.
+func Root(dir string) (string, error) {
+	repo, err := git.PlainOpenWithOptions(dir, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return "", fmt.Errorf("failed to open repository: %w", err)
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return "", fmt.Errorf("failed to open worktree: %w", err)
+	}
+
+	return wt.Filesystem.Root(), nil
+}
+
+// History returns file's commit history within projectPath's repository,
+// most recent first. file is relative to projectPath, matching how git log
+// takes pathspecs.
+func History(projectPath, file string) ([]Commit, error) {
+	repo, err := git.PlainOpen(projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open repository: %w", err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+
+	commitIter, err := repo.Log(&git.LogOptions{From: head.Hash(), FileName: &file})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history for %s: %w", file, err)
+	}
+
+	var commits []Commit
+	err = commitIter.ForEach(func(c *object.Commit) error {
+		commits = append(commits, Commit{
+			Hash:    c.Hash.String(),
+			Author:  c.Author.Name,
+			Email:   c.Author.Email,
+			When:    c.Author.When,
+			Message: strings.TrimSpace(c.Message),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk history for %s: %w", file, err)
+	}
+
+	return commits, nil
+}
+
+// Diff returns a human-readable diff of file between revisions revA and
+// revB (commit hashes as returned by History), so writers can compare two
+// points in a chapter's history from within the TUI.
+func Diff(projectPath, file, revA, revB string) (string, error) {
+	repo, err := git.PlainOpen(projectPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open repository: %w", err)
+	}
+
+	textA, err := fileAtRevision(repo, file, revA)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s at %s: %w", file, revA, err)
+	}
+	textB, err := fileAtRevision(repo, file, revB)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s at %s: %w", file, revB, err)
+	}
+
+	dmp := diffmatchpatch.New()
+	diffs := dmp.DiffMain(textA, textB, false)
+	diffs = dmp.DiffCleanupSemantic(diffs)
+	return dmp.DiffPrettyText(diffs), nil
+}
+
+// fileAtRevision returns file's contents as of rev within repo.
+func fileAtRevision(repo *git.Repository, file, rev string) (string, error) {
+	commit, err := repo.CommitObject(plumbing.NewHash(rev))
+	if err != nil {
+		return "", err
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return "", err
+	}
+
+	entry, err := tree.File(file)
+	if err != nil {
+		return "", err
+	}
+
+	return entry.Contents()
+}
@@ -0,0 +1,95 @@
+package workflow
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// SessionRecord is one completed writing session logged to sessions.jsonl.
+type SessionRecord struct {
+	StartedAt    time.Time     `json:"started_at"`
+	EndedAt      time.Time     `json:"ended_at"`
+	Duration     time.Duration `json:"duration"`
+	WordsWritten int           `json:"words_written"`
+}
+
+// HistoryPath returns the path to the rolling session history log.
+func HistoryPath() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "sessions.jsonl"), nil
+}
+
+// AppendHistory appends rec as a new line to the session history log.
+func AppendHistory(rec SessionRecord) error {
+	path, err := HistoryPath()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+// ReadHistory loads every recorded session, oldest first.
+func ReadHistory() ([]SessionRecord, error) {
+	path, err := HistoryPath()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []SessionRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var rec SessionRecord
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			continue
+		}
+		records = append(records, rec)
+	}
+
+	return records, scanner.Err()
+}
+
+// WordsSince sums WordsWritten for every session that started on or after
+// since, used to report progress toward today's daily goal.
+func WordsSince(records []SessionRecord, since time.Time) int {
+	total := 0
+	for _, rec := range records {
+		if rec.StartedAt.Before(since) {
+			continue
+		}
+		total += rec.WordsWritten
+	}
+	return total
+}
@@ -0,0 +1,28 @@
+package workflow
+
+import (
+	"os/exec"
+	"runtime"
+)
+
+// Notify shows a best-effort desktop notification at phase transitions.
+// Failures are swallowed: a missing notifier binary shouldn't interrupt a
+// writing session.
+func Notify(title, message string) {
+	var cmd *exec.Cmd
+
+	switch runtime.GOOS {
+	case "darwin":
+		script := `display notification "` + message + `" with title "` + title + `"`
+		cmd = exec.Command("osascript", "-e", script)
+	case "linux":
+		cmd = exec.Command("notify-send", title, message)
+	case "windows":
+		script := `New-BurntToastNotification -Text '` + title + `','` + message + `'`
+		cmd = exec.Command("powershell", "-Command", script)
+	default:
+		return
+	}
+
+	_ = cmd.Run()
+}
@@ -0,0 +1,190 @@
+package workflow
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/viper"
+)
+
+// SessionConfig configures a pomodoro/sprint run.
+type SessionConfig struct {
+	WorkDuration  time.Duration // writing.pomodoro
+	BreakDuration time.Duration // writing.breaklength
+	WarnPct       float64       // writing.warnpct, e.g. 0.9 warns in the last 10%
+	ProjectRoot   string        // directory whose markdown word count is snapshotted
+	Viper         *viper.Viper  // if set, workflow.lastsession/lastbreak are persisted here
+}
+
+var (
+	timerStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("63"))
+	warnStyle  = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("214"))
+	breakStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("78"))
+	dimStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+)
+
+type tickMsg time.Time
+
+// sessionModel drives a single work/break cycle countdown.
+type sessionModel struct {
+	cfg SessionConfig
+
+	phase     Phase
+	endsAt    time.Time
+	startedAt time.Time
+
+	startWords int
+	quit       bool
+}
+
+// RunSession runs one work phase followed by one break phase, persisting
+// live state for the statusline exporter. Once the program exits (after the
+// break phase, or earlier if the user quits) it logs a history record whose
+// word count and duration cover the whole run. It returns the number of
+// words written.
+func RunSession(cfg SessionConfig) (int, error) {
+	startWords, err := CountProjectWords(cfg.ProjectRoot)
+	if err != nil {
+		startWords = 0
+	}
+
+	m := sessionModel{
+		cfg:        cfg,
+		phase:      PhaseWork,
+		startedAt:  time.Now(),
+		endsAt:     time.Now().Add(cfg.WorkDuration),
+		startWords: startWords,
+	}
+
+	saveState(m)
+
+	program := tea.NewProgram(m)
+	final, err := program.Run()
+	if err != nil {
+		return 0, err
+	}
+
+	fm := final.(sessionModel)
+
+	endWords, err := CountProjectWords(cfg.ProjectRoot)
+	if err != nil {
+		endWords = fm.startWords
+	}
+	written := endWords - fm.startWords
+	if written < 0 {
+		written = 0
+	}
+
+	_ = AppendHistory(SessionRecord{
+		StartedAt:    fm.startedAt,
+		EndedAt:      time.Now(),
+		Duration:     time.Since(fm.startedAt),
+		WordsWritten: written,
+	})
+	_ = ClearState()
+
+	return written, nil
+}
+
+// persistTimestamp records t under key in v's config file, so it survives
+// across invocations (e.g. "writers workflow daily" reporting when the last
+// sprint/break happened). v is nil when the caller has no config to persist
+// to; a write failure is swallowed the same way saveState's is - a missed
+// timestamp isn't worth failing the session over.
+func persistTimestamp(v *viper.Viper, key string, t time.Time) {
+	if v == nil {
+		return
+	}
+	v.Set(key, t.Format(time.RFC3339))
+	if err := v.WriteConfig(); err != nil {
+		_ = v.SafeWriteConfig()
+	}
+}
+
+func saveState(m sessionModel) {
+	_ = SaveState(State{
+		Active:    true,
+		Phase:     m.phase,
+		StartedAt: m.startedAt,
+		EndsAt:    m.endsAt,
+	})
+}
+
+func (m sessionModel) Init() tea.Cmd {
+	Notify("Writers CLI", "Sprint started — focus time!")
+	return tick()
+}
+
+func tick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return tickMsg(t)
+	})
+}
+
+func (m sessionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c":
+			m.quit = true
+			_ = ClearState()
+			return m, tea.Quit
+		}
+	case tickMsg:
+		if time.Time(msg).Before(m.endsAt) {
+			return m, tick()
+		}
+
+		// Phase transition.
+		switch m.phase {
+		case PhaseWork:
+			m.phase = PhaseBreak
+			m.endsAt = time.Now().Add(m.cfg.BreakDuration)
+			Notify("Writers CLI", "Sprint complete — take a break!")
+			saveState(m)
+			persistTimestamp(m.cfg.Viper, "workflow.lastsession", time.Now())
+			return m, tick()
+		case PhaseBreak:
+			Notify("Writers CLI", "Break's over — back to writing!")
+			persistTimestamp(m.cfg.Viper, "workflow.lastbreak", time.Now())
+			return m, tea.Quit
+		}
+	}
+
+	return m, nil
+}
+
+func (m sessionModel) View() string {
+	if m.quit {
+		return ""
+	}
+
+	remaining := m.endsAt.Sub(time.Now())
+	if remaining < 0 {
+		remaining = 0
+	}
+	mins := int(remaining.Minutes())
+	secs := int(remaining.Seconds()) % 60
+	clock := fmt.Sprintf("%02d:%02d", mins, secs)
+
+	var total time.Duration
+	var label string
+	style := timerStyle
+	switch m.phase {
+	case PhaseWork:
+		total = m.cfg.WorkDuration
+		label = "Writing sprint"
+		if total > 0 && float64(remaining)/float64(total) <= (1-m.cfg.WarnPct) {
+			style = warnStyle
+		}
+	case PhaseBreak:
+		total = m.cfg.BreakDuration
+		label = "Break"
+		style = breakStyle
+	}
+
+	return fmt.Sprintf("\n  ✍ %s: %s\n\n  %s\n",
+		label, style.Render(clock), dimStyle.Render("q to stop"))
+}
@@ -0,0 +1,103 @@
+package workflow
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Phase identifies which part of a pomodoro cycle is currently running.
+type Phase string
+
+const (
+	PhaseWork  Phase = "work"
+	PhaseBreak Phase = "break"
+	PhaseIdle  Phase = "idle"
+)
+
+// State is the live status of a running session, persisted to disk so a
+// separate `writers workflow status`/`i3` invocation can read it.
+type State struct {
+	Active    bool      `json:"active"`
+	Phase     Phase     `json:"phase"`
+	StartedAt time.Time `json:"started_at"`
+	EndsAt    time.Time `json:"ends_at"`
+}
+
+// Remaining returns how long is left in the current phase.
+func (s State) Remaining() time.Duration {
+	remaining := time.Until(s.EndsAt)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// Dir returns ~/.writers-cli, creating it if necessary.
+func Dir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(home, ".writers-cli")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
+
+// StatePath returns the path to the session state file.
+func StatePath() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "session-state.json"), nil
+}
+
+// SaveState writes the current session state to disk.
+func SaveState(s State) error {
+	path, err := StatePath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+// LoadState reads the persisted session state. A missing file is reported as
+// an inactive, idle state rather than an error.
+func LoadState() (State, error) {
+	path, err := StatePath()
+	if err != nil {
+		return State{Phase: PhaseIdle}, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return State{Phase: PhaseIdle}, nil
+		}
+		return State{Phase: PhaseIdle}, err
+	}
+
+	var s State
+	if err := json.Unmarshal(data, &s); err != nil {
+		return State{Phase: PhaseIdle}, err
+	}
+
+	return s, nil
+}
+
+// ClearState marks the session as inactive.
+func ClearState() error {
+	return SaveState(State{Active: false, Phase: PhaseIdle})
+}
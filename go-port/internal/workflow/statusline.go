@@ -0,0 +1,51 @@
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// FormatStatus renders s for embedding in a status bar, in one of
+// "i3bar", "tmux", "json", or "plain" format.
+func FormatStatus(s State, format string) (string, error) {
+	switch format {
+	case "i3bar":
+		return formatPlain(s, "✍"), nil
+	case "tmux":
+		return formatPlain(s, ""), nil
+	case "plain":
+		return formatPlain(s, ""), nil
+	case "json":
+		data, err := json.Marshal(map[string]any{
+			"active":    s.Active,
+			"phase":     string(s.Phase),
+			"remaining": int(s.Remaining().Seconds()),
+		})
+		return string(data), err
+	default:
+		return "", fmt.Errorf("unknown status format: %s", format)
+	}
+}
+
+func formatPlain(s State, icon string) string {
+	if !s.Active {
+		if icon != "" {
+			return icon + " idle"
+		}
+		return "idle"
+	}
+
+	remaining := s.Remaining()
+	label := "work"
+	if s.Phase == PhaseBreak {
+		label = "break"
+	}
+
+	mins := int(remaining.Minutes())
+	secs := int(remaining.Seconds()) % 60
+
+	if icon != "" {
+		return fmt.Sprintf("%s %02d:%02d %s", icon, mins, secs, label)
+	}
+	return fmt.Sprintf("%02d:%02d %s", mins, secs, label)
+}
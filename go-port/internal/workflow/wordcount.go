@@ -0,0 +1,38 @@
+package workflow
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// CountProjectWords sums the word count of every markdown file under root,
+// used to snapshot progress at the start and end of a sprint.
+func CountProjectWords(root string) (int, error) {
+	total := 0
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			base := filepath.Base(path)
+			if base != "." && strings.HasPrefix(base, ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.ToLower(filepath.Ext(path)) != ".md" {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil // skip unreadable files rather than aborting the sprint
+		}
+		total += len(strings.Fields(string(content)))
+		return nil
+	})
+
+	return total, err
+}